@@ -30,6 +30,14 @@ func New[T any](values []T) *Iterator[T] {
 	}
 }
 
+// FromSeq2 creates an iterator from a raw golang iter. Like all iterators, it is expected to stop after yielding an
+// error.
+func FromSeq2[T any](seq iter.Seq2[T, error]) *Iterator[T] {
+	return &Iterator[T]{
+		it: seq,
+	}
+}
+
 // NewP creates an iterator to pointers of values from the given slice.
 func NewP[T any](values []T) *Iterator[*T] {
 	return &Iterator[*T]{
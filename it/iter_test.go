@@ -25,6 +25,22 @@ func TestNew_ReturnsAnIteratorOverTheValues(t *testing.T) {
 	}
 }
 
+func TestFromSeq2_ReturnsAnIteratorOverTheSeq(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	seq := func(yield func(int, error) bool) {
+		for _, v := range values {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+
+	output, err := FromSeq2(seq).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, values, output)
+}
+
 func TestNewP_ReturnsAnIteratorOverPointersToTheValues(t *testing.T) {
 	values := []int{1, 2, 3, 4, 5}
 	iter := NewP(values)
@@ -0,0 +1,46 @@
+package st
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error of the Err returned by CatchUnwind when the function panics.
+type PanicError struct {
+	// Value is the value the function panicked with.
+	Value any
+	// Stack is the stack trace of the panicking goroutine, captured when the panic was recovered.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, nil otherwise.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+
+	return err
+}
+
+// CatchUnwind calls `f` and returns its result as an Ok. If `f` panics, for any reason, the panic is recovered and
+// returned as an Err containing a *PanicError.
+//
+// Context can be added to the error with Result.WrapErr, and errors.Is/errors.As still reach the panic value when it
+// is an error.
+func CatchUnwind[T any](f func() T) (res *Result[T]) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		res = Err[T](&PanicError{
+			Value: r,
+			Stack: debug.Stack(),
+		})
+	}()
+
+	return Ok(f())
+}
@@ -0,0 +1,78 @@
+package st
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func panickingHelper(v any) int {
+	panic(v)
+}
+
+func TestCatchUnwind_ReturnsOkIfNoPanic(t *testing.T) {
+	val := fake.Int()
+
+	assert.Equal(t, Ok(val), CatchUnwind(func() int { return val }))
+}
+
+func TestCatchUnwind_ReturnsErrOnPanic(t *testing.T) {
+	t.Run("non error", func(t *testing.T) {
+		res := CatchUnwind(func() int { return panickingHelper("boom") })
+
+		require.True(t, res.IsErr())
+
+		var target *PanicError
+		require.ErrorAs(t, res.UnwrapErr(), &target)
+
+		assert.Equal(t, "boom", target.Value)
+		assert.Contains(t, string(target.Stack), "panickingHelper")
+		assert.EqualError(t, target, "panic: boom")
+		assert.NoError(t, errors.Unwrap(target))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		res := CatchUnwind(func() int { return panickingHelper(err) })
+
+		require.ErrorIs(t, res.UnwrapErr(), err)
+		assert.EqualError(t, res.UnwrapErr(), "panic: "+err.Error())
+	})
+
+	t.Run("unwrap panic", func(t *testing.T) {
+		res := CatchUnwind(func() int { return Err[int](errNotFound).Unwrap() })
+
+		var target *UnwrapPanic
+		require.ErrorAs(t, res.UnwrapErr(), &target)
+		assert.ErrorIs(t, res.UnwrapErr(), errNotFound)
+	})
+
+	t.Run("runtime error", func(t *testing.T) {
+		res := CatchUnwind(func() int {
+			var m map[string]int
+			m["key"] = 1
+
+			return 0
+		})
+
+		var target *PanicError
+		require.ErrorAs(t, res.UnwrapErr(), &target)
+		assert.Contains(t, res.UnwrapErr().Error(), "assignment to entry in nil map")
+	})
+}
+
+func TestCatchUnwind_WrapErr(t *testing.T) {
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	res := CatchUnwind(func() int { return panickingHelper(err) }).WrapErr("calling worker")
+
+	require.ErrorIs(t, res.UnwrapErr(), err)
+	assert.EqualError(t, res.UnwrapErr(), "calling worker: panic: "+err.Error())
+
+	var target *PanicError
+	require.ErrorAs(t, res.UnwrapErr(), &target)
+	assert.NotEmpty(t, target.Stack)
+}
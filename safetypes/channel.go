@@ -0,0 +1,227 @@
+package st
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+var (
+	// ErrDisconnected is the error returned by Sender.Send when the Receiver is closed, and by Receiver.Recv when all
+	// Senders are closed and no value is left.
+	ErrDisconnected = errors.New("channel disconnected")
+	// ErrSenderClosed is the error returned by Sender.Send when that Sender is closed.
+	ErrSenderClosed = errors.New("sender closed")
+
+	errInvalidCapacity = errors.New("NewBoundedChannel: capacity must be positive")
+)
+
+// NewChannel creates an unbounded multi-producer, single-consumer channel, like Rust's `mpsc::channel`. Sending on
+// it never blocks.
+func NewChannel[T any]() (*Sender[T], *Receiver[T]) {
+	return newChannel[T](0)
+}
+
+// NewBoundedChannel creates a multi-producer, single-consumer channel holding at most `capacity` values, like Rust's
+// `mpsc::sync_channel`. Sending on a full channel blocks until the Receiver takes a value. Panics if `capacity` isn't
+// positive.
+func NewBoundedChannel[T any](capacity int) (*Sender[T], *Receiver[T]) {
+	if capacity <= 0 {
+		panic(fmt.Errorf("%w, got %d", errInvalidCapacity, capacity))
+	}
+
+	return newChannel[T](capacity)
+}
+
+func newChannel[T any](capacity int) (*Sender[T], *Receiver[T]) {
+	c := &channel[T]{
+		mu:         sync.Mutex{},
+		queue:      nil,
+		capacity:   capacity,
+		senders:    1,
+		recvClosed: false,
+		changed:    make(chan struct{}),
+	}
+
+	return &Sender[T]{c: c, closed: false}, &Receiver[T]{c: c}
+}
+
+type channel[T any] struct {
+	mu         sync.Mutex
+	queue      []T
+	capacity   int
+	senders    int
+	recvClosed bool
+	// changed is closed, and replaced, on every change of the channel to wake up the goroutines waiting for one.
+	changed chan struct{}
+}
+
+// notify wakes up the goroutines waiting for a change. Must be called with the lock held.
+func (c *channel[T]) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// wait releases the lock until the next change or until ctx is done, and acquires it again. Must be called with the
+// lock held.
+func (c *channel[T]) wait(ctx context.Context) error {
+	changed := c.changed
+	c.mu.Unlock()
+
+	defer c.mu.Lock()
+
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Sender is the sending half of a channel. It can be cloned to send from multiple goroutines, and every clone must be
+// closed once done so that the Receiver knows no more values are coming.
+type Sender[T any] struct {
+	c      *channel[T]
+	closed bool
+}
+
+// Send sends the value on the channel, waiting for space in a bounded channel. It returns an Ok of the value once it
+// is sent, or an Err containing ErrDisconnected if the Receiver is closed.
+func (s *Sender[T]) Send(val T) *Result[T] {
+	return s.SendCtx(context.Background(), val)
+}
+
+// SendCtx sends the value on the channel like Send, but stops waiting for space in a bounded channel when ctx is
+// done, returning an Err containing the context's error.
+func (s *Sender[T]) SendCtx(ctx context.Context, val T) *Result[T] {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	for {
+		switch {
+		case s.closed:
+			return Err[T](ErrSenderClosed)
+		case s.c.recvClosed:
+			return Err[T](ErrDisconnected)
+		case s.c.capacity == 0 || len(s.c.queue) < s.c.capacity:
+			s.c.queue = append(s.c.queue, val)
+			s.c.notify()
+
+			return Ok(val)
+		}
+
+		err := s.c.wait(ctx)
+		if err != nil {
+			return Err[T](err)
+		}
+	}
+}
+
+// Clone creates a new Sender on the same channel, which must be closed independently. Cloning a closed Sender returns
+// a closed Sender, so that a disconnected channel stays disconnected.
+func (s *Sender[T]) Clone() *Sender[T] {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	if s.closed {
+		return &Sender[T]{c: s.c, closed: true}
+	}
+
+	s.c.senders++
+
+	return &Sender[T]{c: s.c, closed: false}
+}
+
+// Close closes the Sender. Once all Senders are closed, the Receiver gets the remaining values, then ErrDisconnected.
+// Calling it more than once has no effect.
+func (s *Sender[T]) Close() {
+	s.c.mu.Lock()
+	defer s.c.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	s.c.senders--
+	s.c.notify()
+}
+
+// Receiver is the receiving half of a channel.
+type Receiver[T any] struct {
+	c *channel[T]
+}
+
+// Recv waits for a value and returns it as an Ok. It returns an Err containing ErrDisconnected if all Senders are
+// closed and no value is left, or an Err containing the context's error if ctx is done first.
+func (r *Receiver[T]) Recv(ctx context.Context) *Result[T] {
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	for {
+		if len(r.c.queue) > 0 {
+			return Ok(r.pop())
+		}
+
+		if r.c.senders == 0 || r.c.recvClosed {
+			return Err[T](ErrDisconnected)
+		}
+
+		err := r.c.wait(ctx)
+		if err != nil {
+			return Err[T](err)
+		}
+	}
+}
+
+// TryRecv returns the next value without waiting, or None if there is none.
+func (r *Receiver[T]) TryRecv() *Option[T] {
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	if len(r.c.queue) == 0 {
+		return None[T]()
+	}
+
+	return Some(r.pop())
+}
+
+// Iter returns an iterator over the received values, which ends once all Senders are closed and no value is left.
+func (r *Receiver[T]) Iter() *it.Iterator[T] {
+	return it.FromSeq2(func(yield func(T, error) bool) {
+		for {
+			res := r.Recv(context.Background())
+			if res.IsErr() || !yield(res.Unwrap(), nil) {
+				return
+			}
+		}
+	})
+}
+
+// Close closes the Receiver: the remaining values are dropped, and sending returns ErrDisconnected.
+func (r *Receiver[T]) Close() {
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	r.c.recvClosed = true
+	r.c.queue = nil
+	r.c.notify()
+}
+
+// pop removes the first value of the queue and returns it. Must be called with the lock held.
+func (r *Receiver[T]) pop() T {
+	var zero T
+
+	val := r.c.queue[0]
+	r.c.queue[0] = zero
+	r.c.queue = r.c.queue[1:]
+
+	if r.c.capacity > 0 {
+		r.c.notify()
+	}
+
+	return val
+}
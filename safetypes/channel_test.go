@@ -0,0 +1,172 @@
+package st
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannel_SendRecv(t *testing.T) {
+	tx, rx := NewChannel[int]()
+
+	for i := range 100 {
+		assert.Equal(t, Ok(i), tx.Send(i))
+	}
+
+	for i := range 100 {
+		assert.Equal(t, Ok(i), rx.Recv(t.Context()))
+	}
+}
+
+func TestReceiver_Recv(t *testing.T) {
+	t.Run("waits for a value", func(t *testing.T) {
+		tx, rx := NewChannel[string]()
+
+		go func() {
+			time.Sleep(time.Millisecond)
+			tx.Send("value")
+		}()
+
+		assert.Equal(t, Ok("value"), rx.Recv(t.Context()))
+	})
+
+	t.Run("disconnected", func(t *testing.T) {
+		tx, rx := NewChannel[int]()
+		tx.Send(1)
+		tx.Close()
+
+		assert.Equal(t, Ok(1), rx.Recv(t.Context()))
+		assert.Equal(t, Err[int](ErrDisconnected), rx.Recv(t.Context()))
+	})
+
+	t.Run("ctx done", func(t *testing.T) {
+		tx, rx := NewChannel[int]()
+		defer tx.Close()
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, Err[int](context.DeadlineExceeded), rx.Recv(ctx))
+	})
+}
+
+func TestReceiver_TryRecv(t *testing.T) {
+	tx, rx := NewChannel[int]()
+
+	assert.Equal(t, None[int](), rx.TryRecv())
+
+	tx.Send(42)
+
+	assert.Equal(t, Some(42), rx.TryRecv())
+	assert.Equal(t, None[int](), rx.TryRecv())
+}
+
+func TestReceiver_Iter(t *testing.T) {
+	tx, rx := NewChannel[int]()
+
+	const senders = 10
+
+	for i := range senders {
+		s := tx.Clone()
+
+		go func() {
+			defer s.Close()
+
+			for j := range 10 {
+				s.Send(i*10 + j)
+			}
+		}()
+	}
+
+	tx.Close()
+
+	values, err := rx.Iter().Collect()
+	require.NoError(t, err)
+
+	expected := make([]int, 0, senders*10)
+	for i := range senders * 10 {
+		expected = append(expected, i)
+	}
+
+	assert.ElementsMatch(t, expected, values)
+}
+
+func TestReceiver_Close(t *testing.T) {
+	tx, rx := NewChannel[int]()
+	tx.Send(1)
+
+	rx.Close()
+
+	assert.Equal(t, Err[int](ErrDisconnected), tx.Send(2))
+	assert.Equal(t, None[int](), rx.TryRecv())
+}
+
+func TestSender_Close(t *testing.T) {
+	tx, rx := NewChannel[int]()
+	other := tx.Clone()
+
+	tx.Close()
+	tx.Close()
+
+	assert.Equal(t, Err[int](ErrSenderClosed), tx.Send(1))
+	assert.Equal(t, Ok(2), other.Send(2))
+	assert.Equal(t, Ok(2), rx.Recv(t.Context()))
+
+	other.Close()
+
+	assert.Equal(t, Err[int](ErrDisconnected), rx.Recv(t.Context()))
+}
+
+func TestSender_Clone(t *testing.T) {
+	tx, rx := NewChannel[int]()
+	tx.Close()
+
+	clone := tx.Clone()
+
+	assert.Equal(t, Err[int](ErrSenderClosed), clone.Send(1))
+	assert.Equal(t, Err[int](ErrDisconnected), rx.Recv(t.Context()))
+
+	clone.Close()
+
+	assert.Equal(t, Err[int](ErrDisconnected), rx.Recv(t.Context()))
+}
+
+func TestBoundedChannel(t *testing.T) {
+	t.Run("blocks when full", func(t *testing.T) {
+		tx, rx := NewBoundedChannel[int](2)
+
+		tx.Send(1)
+		tx.Send(2)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, Err[int](context.DeadlineExceeded), tx.SendCtx(ctx, 3))
+
+		sent := Go(func() (int, error) { return tx.Send(3).Expand() })
+
+		assert.Equal(t, Ok(1), rx.Recv(t.Context()))
+		assert.Equal(t, Ok(3), sent.Await(t.Context()))
+		assert.Equal(t, Ok(2), rx.Recv(t.Context()))
+		assert.Equal(t, Ok(3), rx.Recv(t.Context()))
+	})
+
+	t.Run("unblocks on receiver close", func(t *testing.T) {
+		tx, rx := NewBoundedChannel[int](1)
+		tx.Send(1)
+
+		sent := Go(func() (int, error) { return tx.Send(2).Expand() })
+		rx.Close()
+
+		assert.Equal(t, Err[int](ErrDisconnected), sent.Await(t.Context()))
+	})
+
+	t.Run("invalid capacity", func(t *testing.T) {
+		assert.PanicsWithError(t, "NewBoundedChannel: capacity must be positive, got 0", func() {
+			NewBoundedChannel[int](0)
+		})
+	})
+}
@@ -0,0 +1,26 @@
+package st
+
+import (
+	"time"
+)
+
+// Clock is the source of time used by Retry and Memoize. It can be replaced in tests to control time.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock based on the time package.
+var SystemClock Clock = systemClock{} //nolint:gochecknoglobals // Stateless default
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,18 @@
+package st
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemClock(t *testing.T) {
+	before := time.Now()
+	now := SystemClock.Now()
+
+	assert.False(t, now.Before(before))
+
+	fired := <-SystemClock.After(time.Millisecond)
+	assert.GreaterOrEqual(t, fired.Sub(now), time.Millisecond)
+}
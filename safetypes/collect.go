@@ -0,0 +1,112 @@
+package st
+
+import (
+	"errors"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+// CollectResults collects a slice of Results into a Result of a slice. Returns the first Err if any, otherwise Ok
+// with all values.
+func CollectResults[T any](results []*Result[T]) *Result[[]T] {
+	return CollectResultsIter(it.New(results))
+}
+
+// CollectResultsIter collects an iterator of Results into a Result of a slice. Returns the first Err (or iterator
+// error) if any, otherwise Ok with all values.
+func CollectResultsIter[T any](iter *it.Iterator[*Result[T]]) *Result[[]T] {
+	output := make([]T, 0)
+
+	for res, err := range iter.Iter() {
+		if err != nil {
+			return Err[[]T](err)
+		}
+
+		if res.IsErr() {
+			return Err[[]T](res.UnwrapErr())
+		}
+
+		output = append(output, res.Unwrap())
+	}
+
+	return Ok(output)
+}
+
+// CollectOptions collects a slice of Options into an Option of a slice. Returns None if any Option is None, otherwise
+// Some with all values.
+func CollectOptions[T any](options []*Option[T]) *Option[[]T] {
+	output := make([]T, 0, len(options))
+
+	for _, opt := range options {
+		if opt.IsNone() {
+			return None[[]T]()
+		}
+
+		output = append(output, opt.Unwrap())
+	}
+
+	return Some(output)
+}
+
+// CollectOptionsIter collects an iterator of Options into a Result of an Option of a slice. The Result is an Err if
+// the iterator fails, otherwise it contains None if any Option is None, or Some with all values.
+func CollectOptionsIter[T any](iter *it.Iterator[*Option[T]]) *Result[*Option[[]T]] {
+	output := make([]T, 0)
+
+	for opt, err := range iter.Iter() {
+		if err != nil {
+			return Err[*Option[[]T]](err)
+		}
+
+		if opt.IsNone() {
+			return Ok(None[[]T]())
+		}
+
+		output = append(output, opt.Unwrap())
+	}
+
+	return Ok(Some(output))
+}
+
+// PartitionResults splits a slice of Results into the values of the Ok variants and the errors of the Err variants,
+// preserving their order.
+func PartitionResults[T any](results []*Result[T]) ([]T, []error) {
+	return PartitionResultsIter(it.New(results))
+}
+
+// PartitionResultsIter splits an iterator of Results into the values of the Ok variants and the errors of the Err
+// variants, preserving their order. An iterator error is added to the errors.
+func PartitionResultsIter[T any](iter *it.Iterator[*Result[T]]) ([]T, []error) {
+	values := make([]T, 0)
+	errs := make([]error, 0)
+
+	for res, err := range iter.Iter() {
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case res.IsErr():
+			errs = append(errs, res.UnwrapErr())
+		default:
+			values = append(values, res.Unwrap())
+		}
+	}
+
+	return values, errs
+}
+
+// JoinResults collects a slice of Results into a Result of a slice. Unlike CollectResults, all Results are inspected:
+// if any is an Err, the returned Err contains all errors, joined with errors.Join.
+func JoinResults[T any](results []*Result[T]) *Result[[]T] {
+	return JoinResultsIter(it.New(results))
+}
+
+// JoinResultsIter collects an iterator of Results into a Result of a slice. Unlike CollectResultsIter, all Results are
+// inspected: if any is an Err, the returned Err contains all errors, joined with errors.Join.
+func JoinResultsIter[T any](iter *it.Iterator[*Result[T]]) *Result[[]T] {
+	values, errs := PartitionResultsIter(iter)
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+
+	return Ok(values)
+}
@@ -0,0 +1,139 @@
+package st
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+func failingIter[T any](values []T, err error) *it.Iterator[T] {
+	return it.FromSeq2(func(yield func(T, error) bool) {
+		for _, v := range values {
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		var zero T
+		yield(zero, err)
+	})
+}
+
+func TestCollectResults_ReturnsAllValuesOrTheFirstErr(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		assert.Equal(t, Ok([]int{1, 2, 3}), CollectResults([]*Result[int]{Ok(1), Ok(2), Ok(3)}))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, Ok([]int{}), CollectResults([]*Result[int]{}))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err1 := errors.New(fake.RandomStringWithLength(8))
+		err2 := errors.New(fake.RandomStringWithLength(8))
+
+		res := CollectResults([]*Result[int]{Ok(1), Err[int](err1), Err[int](err2)})
+
+		assert.Equal(t, Err[[]int](err1), res)
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		res := CollectResultsIter(failingIter([]*Result[int]{Ok(1)}, err))
+
+		assert.Equal(t, Err[[]int](err), res)
+	})
+}
+
+func TestCollectOptions_ReturnsAllValuesOrNone(t *testing.T) {
+	assert.Equal(t, Some([]int{1, 2}), CollectOptions([]*Option[int]{Some(1), Some(2)}))
+	assert.Equal(t, Some([]int{}), CollectOptions([]*Option[int]{}))
+	assert.Equal(t, None[[]int](), CollectOptions([]*Option[int]{Some(1), None[int](), Some(2)}))
+}
+
+func TestCollectOptionsIter_ReturnsAllValuesOrNone(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		res := CollectOptionsIter(it.New([]*Option[int]{Some(1), Some(2)}))
+
+		assert.Equal(t, Ok(Some([]int{1, 2})), res)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		res := CollectOptionsIter(it.New([]*Option[int]{Some(1), None[int]()}))
+
+		assert.Equal(t, Ok(None[[]int]()), res)
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		res := CollectOptionsIter(failingIter([]*Option[int]{Some(1)}, err))
+
+		assert.Equal(t, Err[*Option[[]int]](err), res)
+	})
+}
+
+func TestPartitionResults_SplitsValuesAndErrors(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		err1 := errors.New(fake.RandomStringWithLength(8))
+		err2 := errors.New(fake.RandomStringWithLength(8))
+
+		values, errs := PartitionResults(
+			[]*Result[int]{Ok(1), Err[int](err1), Ok(2), Err[int](err2)},
+		)
+
+		assert.Equal(t, []int{1, 2}, values)
+		assert.Equal(t, []error{err1, err2}, errs)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		values, errs := PartitionResults([]*Result[int]{})
+
+		assert.Empty(t, values)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		err1 := errors.New(fake.RandomStringWithLength(8))
+		err2 := errors.New(fake.RandomStringWithLength(8))
+
+		values, errs := PartitionResultsIter(
+			failingIter([]*Result[int]{Ok(1), Err[int](err1)}, err2),
+		)
+
+		assert.Equal(t, []int{1}, values)
+		assert.Equal(t, []error{err1, err2}, errs)
+	})
+}
+
+func TestJoinResults_ReturnsAllValuesOrAllErrors(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		assert.Equal(t, Ok([]int{1, 2}), JoinResults([]*Result[int]{Ok(1), Ok(2)}))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err1 := errors.New(fake.RandomStringWithLength(8))
+		err2 := errors.New(fake.RandomStringWithLength(8))
+
+		res := JoinResults([]*Result[int]{Err[int](err1), Ok(1), Err[int](err2)})
+
+		require.True(t, res.IsErr())
+		require.ErrorIs(t, res.UnwrapErr(), err1)
+		require.ErrorIs(t, res.UnwrapErr(), err2)
+		assert.EqualError(t, res.UnwrapErr(), errors.Join(err1, err2).Error())
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		res := JoinResultsIter(failingIter([]*Result[int]{Ok(1)}, err))
+
+		require.True(t, res.IsErr())
+		assert.ErrorIs(t, res.UnwrapErr(), err)
+	})
+}
@@ -0,0 +1,110 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// ContextError is an error annotated with a context message and the location where the context was added. It is
+// created by Result.Context and Result.Contextf.
+//
+// Formatting a ContextError with `%+v` prints the whole chain of causes with their locations, one per line.
+type ContextError struct {
+	// Msg is the context message.
+	Msg string
+	// File is the source file where the context was added.
+	File string
+	// Line is the line in File where the context was added.
+	Line int
+
+	err error
+}
+
+func newContextError(msg string, err error, skip int) *ContextError {
+	_, file, line, _ := runtime.Caller(skip + 1)
+
+	return &ContextError{
+		Msg:  msg,
+		File: file,
+		Line: line,
+		err:  err,
+	}
+}
+
+func (e *ContextError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Msg, e.err)
+}
+
+// Unwrap returns the error the context was added to.
+func (e *ContextError) Unwrap() error {
+	return e.err
+}
+
+// Format implements fmt.Formatter. `%+v` prints the chain of causes with their locations, other verbs print the same
+// as Error.
+func (e *ContextError) Format(s fmt.State, verb rune) {
+	if verb != 'v' || !s.Flag('+') {
+		_, _ = fmt.Fprintf(s, fmt.FormatString(s, verb), e.Error())
+
+		return
+	}
+
+	writeContextLine(s, e)
+
+	cause := e.err
+	if cause == nil {
+		return
+	}
+
+	_, _ = io.WriteString(s, "\n\nCaused by:")
+
+	for idx := 0; cause != nil; idx++ {
+		_, _ = fmt.Fprintf(s, "\n    %d: ", idx)
+		writeContextLine(s, cause)
+
+		cause = errors.Unwrap(cause)
+	}
+}
+
+// writeContextLine writes a single link of an error chain: its own message, without the message of its cause, and its
+// location if known.
+func writeContextLine(w io.Writer, err error) {
+	ctx, ok := err.(*ContextError) //nolint:errorlint // Only this link matters, not its causes
+	if ok {
+		_, _ = fmt.Fprintf(w, "%s (%s:%d)", ctx.Msg, ctx.File, ctx.Line)
+
+		return
+	}
+
+	msg := err.Error()
+
+	cause := errors.Unwrap(err)
+	if cause != nil {
+		msg = strings.TrimSuffix(msg, ": "+cause.Error())
+	}
+
+	_, _ = io.WriteString(w, msg)
+}
+
+// Context wraps the error of an Err with a context message, recording the location of the caller. Leaves Ok
+// untouched.
+func (r *Result[T]) Context(msg string) *Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return Err[T](newContextError(msg, r.err, 1))
+}
+
+// Contextf wraps the error of an Err with a formatted context message, recording the location of the caller. Leaves
+// Ok untouched.
+func (r *Result[T]) Contextf(format string, args ...any) *Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return Err[T](newContextError(fmt.Sprintf(format, args...), r.err, 1))
+}
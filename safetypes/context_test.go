@@ -0,0 +1,89 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+
+	return line
+}
+
+func TestResult_Context(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(fake.Int())
+
+		assert.Same(t, r, r.Context(fake.RandomStringWithLength(8)))
+		assert.Same(t, r, r.Contextf("%s", fake.RandomStringWithLength(8)))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		msg := fake.RandomStringWithLength(8)
+
+		line := currentLine() + 1
+		res := Err[int](err).Context(msg)
+
+		require.ErrorIs(t, res.UnwrapErr(), err)
+		assert.EqualError(t, res.UnwrapErr(), msg+": "+err.Error())
+
+		var ctx *ContextError
+		require.ErrorAs(t, res.UnwrapErr(), &ctx)
+		assert.Equal(t, msg, ctx.Msg)
+		assert.Contains(t, ctx.File, "context_test.go")
+		assert.Equal(t, line, ctx.Line)
+	})
+
+	t.Run("Errf", func(t *testing.T) {
+		err := &ValidationError{Field: "email", Reason: "missing @"}
+
+		line := currentLine() + 1
+		res := Err[int](err).Contextf("user %d", 42)
+
+		var target *ValidationError
+		require.ErrorAs(t, res.UnwrapErr(), &target)
+		assert.EqualError(t, res.UnwrapErr(), "user 42: invalid email: missing @")
+
+		var ctx *ContextError
+		require.ErrorAs(t, res.UnwrapErr(), &ctx)
+		assert.Equal(t, line, ctx.Line)
+	})
+}
+
+func TestContextError_Format(t *testing.T) {
+	first := currentLine() + 1
+	res := Err[int](errNotFound).Context("loading user")
+	res = res.WrapErr("in handler")
+	second := currentLine() + 1
+	res = res.Contextf("serving %s", "/users/42")
+
+	err := res.UnwrapErr()
+
+	t.Run("v", func(t *testing.T) {
+		expected := "serving /users/42: in handler: loading user: not found"
+
+		assert.Equal(t, expected+"|"+expected, fmt.Sprintf("%v|%s", err, err))
+		assert.Equal(t, fmt.Sprintf("%q", expected), fmt.Sprintf("%q", err))
+	})
+
+	t.Run("+v", func(t *testing.T) {
+		var ctx *ContextError
+		require.ErrorAs(t, err, &ctx)
+
+		expected := fmt.Sprintf(`serving /users/42 (%[1]s:%[2]d)
+
+Caused by:
+    0: in handler
+    1: loading user (%[1]s:%[3]d)
+    2: not found`, ctx.File, second, first)
+
+		assert.Equal(t, expected, fmt.Sprintf("%+v", err))
+	})
+}
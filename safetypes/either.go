@@ -0,0 +1,199 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Left creates a Left variant of Either from the value.
+func Left[L any, R any](val L) *Either[L, R] {
+	var r R
+
+	return &Either[L, R]{
+		isLeft: true,
+		left:   val,
+		right:  r,
+	}
+}
+
+// Right creates a Right variant of Either from the value.
+func Right[L any, R any](val R) *Either[L, R] {
+	var l L
+
+	return &Either[L, R]{
+		isLeft: false,
+		left:   l,
+		right:  val,
+	}
+}
+
+// MapLeft maps an Either<L, R> to Either<M, R> by applying a function to a contained Left value, leaving a Right value
+// untouched.
+func MapLeft[L any, R any, M any](e *Either[L, R], f func(L) M) *Either[M, R] {
+	if e.IsRight() {
+		return Right[M](e.UnwrapRight())
+	}
+
+	return Left[M, R](f(e.UnwrapLeft()))
+}
+
+// MapRight maps an Either<L, R> to Either<L, M> by applying a function to a contained Right value, leaving a Left
+// value untouched.
+func MapRight[L any, R any, M any](e *Either[L, R], f func(R) M) *Either[L, M] {
+	if e.IsLeft() {
+		return Left[L, M](e.UnwrapLeft())
+	}
+
+	return Right[L](f(e.UnwrapRight()))
+}
+
+// MatchEither calls `left` with the contained value if the Either is Left, otherwise calls `right` with the contained
+// value, and returns the result.
+func MatchEither[L any, R any, U any](e *Either[L, R], left func(L) U, right func(R) U) U {
+	if e.IsLeft() {
+		return left(e.UnwrapLeft())
+	}
+
+	return right(e.UnwrapRight())
+}
+
+// EitherAsResult converts an Either to an Ok when it is Right or an Err when it is Left.
+func EitherAsResult[T any](e *Either[error, T]) *Result[T] {
+	if e.IsLeft() {
+		return Err[T](e.UnwrapLeft())
+	}
+
+	return Ok(e.UnwrapRight())
+}
+
+// Either is a type that represents one of two possible values, either Left or Right. Unlike Result, both variants
+// are legitimate values.
+type Either[L any, R any] struct {
+	isLeft bool
+	left   L
+	right  R
+}
+
+// IsLeft returns true if the Either is a Left value.
+func (e *Either[L, R]) IsLeft() bool {
+	return e.isLeft
+}
+
+// IsLeftAnd returns true if the Either is a Left and the value inside of it matches a predicate.
+func (e *Either[L, R]) IsLeftAnd(f func(L) bool) bool {
+	return e.isLeft && f(e.left)
+}
+
+// IsRight returns true if the Either is a Right value.
+func (e *Either[L, R]) IsRight() bool {
+	return !e.isLeft
+}
+
+// IsRightAnd returns true if the Either is a Right and the value inside of it matches a predicate.
+func (e *Either[L, R]) IsRightAnd(f func(R) bool) bool {
+	return !e.isLeft && f(e.right)
+}
+
+// UnwrapLeft returns the contained Left value. Panics if the value is a Right.
+func (e *Either[L, R]) UnwrapLeft() L {
+	if e.isLeft {
+		return e.left
+	}
+
+	panic(
+		newUnwrapPanic(fmt.Errorf("called `Either.UnwrapLeft()` on a `Right` value: %v", e.right)),
+	)
+}
+
+// UnwrapRight returns the contained Right value. Panics if the value is a Left.
+func (e *Either[L, R]) UnwrapRight() R {
+	if !e.isLeft {
+		return e.right
+	}
+
+	panic(
+		newUnwrapPanic(fmt.Errorf("called `Either.UnwrapRight()` on a `Left` value: %v", e.left)),
+	)
+}
+
+// ExpectLeft returns the contained Left value. Panics if the value is a Right with a custom panic message provided by
+// msg.
+func (e *Either[L, R]) ExpectLeft(msg string) L {
+	if e.isLeft {
+		return e.left
+	}
+
+	panic(newUnwrapPanic(errors.New(msg)))
+}
+
+// ExpectRight returns the contained Right value. Panics if the value is a Left with a custom panic message provided by
+// msg.
+func (e *Either[L, R]) ExpectRight(msg string) R {
+	if !e.isLeft {
+		return e.right
+	}
+
+	panic(newUnwrapPanic(errors.New(msg)))
+}
+
+// LeftOr returns the contained Left value or a provided default.
+func (e *Either[L, R]) LeftOr(def L) L {
+	if e.isLeft {
+		return e.left
+	}
+
+	return def
+}
+
+// RightOr returns the contained Right value or a provided default.
+func (e *Either[L, R]) RightOr(def R) R {
+	if !e.isLeft {
+		return e.right
+	}
+
+	return def
+}
+
+// Flip converts Left to Right and Right to Left.
+func (e *Either[L, R]) Flip() *Either[R, L] {
+	if e.isLeft {
+		return Right[R](e.left)
+	}
+
+	return Left[R, L](e.right)
+}
+
+// LeftOption converts an Either to a Some when it is Left or None when it is Right.
+func (e *Either[L, R]) LeftOption() *Option[L] {
+	if e.isLeft {
+		return Some(e.left)
+	}
+
+	return None[L]()
+}
+
+// RightOption converts an Either to a Some when it is Right or None when it is Left.
+func (e *Either[L, R]) RightOption() *Option[R] {
+	if !e.isLeft {
+		return Some(e.right)
+	}
+
+	return None[R]()
+}
+
+func (e *Either[L, R]) String() string {
+	if e.isLeft {
+		return fmt.Sprintf("Left(%v)", e.left)
+	}
+
+	return fmt.Sprintf("Right(%v)", e.right)
+}
+
+// AsEither converts a Result to a Right when it is Ok or a Left when it is Err.
+func (r *Result[T]) AsEither() *Either[error, T] {
+	if r.ok {
+		return Right[error](r.val)
+	}
+
+	return Left[error, T](r.err)
+}
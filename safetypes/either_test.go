@@ -0,0 +1,161 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapLeft_ReturnsANewEitherWithMappedLeftValue(t *testing.T) {
+	t.Run("left", func(t *testing.T) {
+		val := fake.Int()
+
+		expected := Left[string, bool](strconv.Itoa(val))
+
+		assert.Equal(t, expected, MapLeft(Left[int, bool](val), strconv.Itoa))
+	})
+
+	t.Run("right", func(t *testing.T) {
+		f := func(int) string {
+			assert.Fail(t, "mapper should not have been called")
+
+			return ""
+		}
+
+		assert.Equal(t, Right[string](true), MapLeft(Right[int](true), f))
+	})
+}
+
+func TestMapRight_ReturnsANewEitherWithMappedRightValue(t *testing.T) {
+	t.Run("left", func(t *testing.T) {
+		f := func(int) string {
+			assert.Fail(t, "mapper should not have been called")
+
+			return ""
+		}
+
+		assert.Equal(t, Left[bool, string](true), MapRight(Left[bool, int](true), f))
+	})
+
+	t.Run("right", func(t *testing.T) {
+		val := fake.Int()
+
+		assert.Equal(t, Right[bool](strconv.Itoa(val)), MapRight(Right[bool](val), strconv.Itoa))
+	})
+}
+
+func TestMatchEither_CallsTheMatchingArm(t *testing.T) {
+	left := func(v int) string { return "left " + strconv.Itoa(v) }
+	right := func(v bool) string { return "right " + strconv.FormatBool(v) }
+
+	assert.Equal(t, "left 42", MatchEither(Left[int, bool](42), left, right))
+	assert.Equal(t, "right true", MatchEither(Right[int](true), left, right))
+}
+
+func TestEitherAsResult_ConvertsRightToOk(t *testing.T) {
+	val := fake.Int()
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	assert.Equal(t, Ok(val), EitherAsResult(Right[error](val)))
+	assert.Equal(t, Err[int](err), EitherAsResult(Left[error, int](err)))
+}
+
+func TestResult_AsEither(t *testing.T) {
+	val := fake.Int()
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	assert.Equal(t, Right[error](val), Ok(val).AsEither())
+	assert.Equal(t, Left[error, int](err), Err[int](err).AsEither())
+}
+
+func TestEither_Predicates(t *testing.T) {
+	l := Left[int, string](fake.Int())
+	r := Right[int](fake.RandomStringWithLength(8))
+
+	assert.True(t, l.IsLeft())
+	assert.False(t, l.IsRight())
+	assert.True(t, l.IsLeftAnd(func(int) bool { return true }))
+	assert.False(t, l.IsLeftAnd(func(int) bool { return false }))
+	assert.False(t, l.IsRightAnd(func(string) bool { return true }))
+
+	assert.False(t, r.IsLeft())
+	assert.True(t, r.IsRight())
+	assert.False(t, r.IsLeftAnd(func(int) bool { return true }))
+	assert.True(t, r.IsRightAnd(func(string) bool { return true }))
+	assert.False(t, r.IsRightAnd(func(string) bool { return false }))
+}
+
+func TestEither_Unwrap(t *testing.T) {
+	t.Run("Left", func(t *testing.T) {
+		val := fake.Int()
+		e := Left[int, string](val)
+
+		assert.Equal(t, val, e.UnwrapLeft())
+		assert.Equal(t, val, e.ExpectLeft(fake.RandomStringWithLength(8)))
+		assert.Equal(t, val, e.LeftOr(fake.Int()))
+
+		def := fake.RandomStringWithLength(8)
+		assert.Equal(t, def, e.RightOr(def))
+
+		expected := fmt.Sprintf("called `Either.UnwrapRight()` on a `Left` value: %v", val)
+		assert.PanicsWithError(t, expected, func() {
+			_ = e.UnwrapRight()
+		})
+
+		msg := fake.RandomStringWithLength(8)
+		assert.PanicsWithError(t, msg, func() {
+			_ = e.ExpectRight(msg)
+		})
+	})
+
+	t.Run("Right", func(t *testing.T) {
+		val := fake.RandomStringWithLength(8)
+		e := Right[int](val)
+
+		assert.Equal(t, val, e.UnwrapRight())
+		assert.Equal(t, val, e.ExpectRight(fake.RandomStringWithLength(8)))
+		assert.Equal(t, val, e.RightOr(fake.RandomStringWithLength(8)))
+
+		def := fake.Int()
+		assert.Equal(t, def, e.LeftOr(def))
+
+		expected := fmt.Sprintf("called `Either.UnwrapLeft()` on a `Right` value: %v", val)
+		assert.PanicsWithError(t, expected, func() {
+			_ = e.UnwrapLeft()
+		})
+
+		msg := fake.RandomStringWithLength(8)
+		assert.PanicsWithError(t, msg, func() {
+			_ = e.ExpectLeft(msg)
+		})
+	})
+}
+
+func TestEither_Flip(t *testing.T) {
+	l := fake.Int()
+	r := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, Right[string](l), Left[int, string](l).Flip())
+	assert.Equal(t, Left[string, int](r), Right[int](r).Flip())
+}
+
+func TestEither_AsOption(t *testing.T) {
+	l := fake.Int()
+	r := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, Some(l), Left[int, string](l).LeftOption())
+	assert.Equal(t, None[string](), Left[int, string](l).RightOption())
+	assert.Equal(t, None[int](), Right[int](r).LeftOption())
+	assert.Equal(t, Some(r), Right[int](r).RightOption())
+}
+
+func TestEither_String(t *testing.T) {
+	l := fake.Int()
+	r := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, fmt.Sprintf("Left(%v)", l), Left[int, string](l).String())
+	assert.Equal(t, fmt.Sprintf("Right(%v)", r), Right[int](r).String())
+}
@@ -0,0 +1,178 @@
+package st
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ErrorCodec describes how a family of errors is encoded to and decoded from its serialized payload.
+type ErrorCodec interface {
+	// Encode looks for the error handled by the codec in the chain of err, and returns its payload. The boolean is
+	// false if the chain doesn't contain such an error.
+	Encode(err error) (json.RawMessage, bool, error)
+	// Decode rebuilds the error handled by the codec from its payload.
+	Decode(data json.RawMessage) (error, error)
+}
+
+var errNilDecodedError = errors.New("codec decoded a nil error")
+
+//nolint:gochecknoglobals // Codecs are registered application-wide, like database/sql drivers
+var errorCodecs = &errorRegistry{
+	mu:     sync.RWMutex{},
+	codecs: nil,
+}
+
+type namedCodec struct {
+	code  string
+	codec ErrorCodec
+}
+
+type errorRegistry struct {
+	mu     sync.RWMutex
+	codecs []namedCodec
+}
+
+// RegisterErrorCodec registers an ErrorCodec under the given code, replacing any codec previously registered under
+// the same code.
+//
+// When encoding an error, codecs are tried in registration order and the first one to find its error in the chain is
+// used.
+func RegisterErrorCodec(code string, codec ErrorCodec) {
+	errorCodecs.mu.Lock()
+	defer errorCodecs.mu.Unlock()
+
+	idx := slices.IndexFunc(errorCodecs.codecs, func(c namedCodec) bool { return c.code == code })
+	if idx >= 0 {
+		errorCodecs.codecs[idx].codec = codec
+
+		return
+	}
+
+	errorCodecs.codecs = append(errorCodecs.codecs, namedCodec{code: code, codec: codec})
+}
+
+// RegisterSentinelError registers a sentinel error under the given code. Decoded errors match the sentinel with
+// errors.Is.
+func RegisterSentinelError(code string, sentinel error) {
+	RegisterErrorCodec(code, sentinelCodec{sentinel: sentinel})
+}
+
+// RegisterErrorType registers the error type E under the given code. E is serialized with encoding/json, and decoded
+// errors match E with errors.As.
+func RegisterErrorType[E error](code string) {
+	RegisterErrorCodec(code, typeCodec[E]{})
+}
+
+// UnregisterError removes the codec registered under the given code, if any.
+func UnregisterError(code string) {
+	errorCodecs.mu.Lock()
+	defer errorCodecs.mu.Unlock()
+
+	errorCodecs.codecs = slices.DeleteFunc(
+		errorCodecs.codecs,
+		func(c namedCodec) bool { return c.code == code },
+	)
+}
+
+// snapshot returns a copy of the registered codecs, so that they are called without holding the lock.
+func (r *errorRegistry) snapshot() []namedCodec {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.codecs)
+}
+
+func (r *errorRegistry) encode(err error) (string, json.RawMessage, error) {
+	for _, c := range r.snapshot() {
+		data, ok, encErr := c.codec.Encode(err)
+		if encErr != nil {
+			return "", nil, fmt.Errorf("encoding error with codec %q: %w", c.code, encErr)
+		}
+
+		if ok {
+			return c.code, data, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+func (r *errorRegistry) decode(code string, msg string, data json.RawMessage) (error, error) {
+	codecs := r.snapshot()
+
+	idx := slices.IndexFunc(codecs, func(c namedCodec) bool { return c.code == code })
+	if code == "" || idx < 0 {
+		return errors.New(msg), nil
+	}
+
+	err, decErr := codecs[idx].codec.Decode(data)
+	if decErr != nil {
+		return nil, fmt.Errorf("decoding error with codec %q: %w", code, decErr)
+	}
+
+	if err == nil {
+		return nil, fmt.Errorf("decoding error with codec %q: %w", code, errNilDecodedError)
+	}
+
+	if err.Error() == msg {
+		return err, nil
+	}
+
+	return &decodedError{msg: msg, err: err}, nil
+}
+
+// decodedError restores the message of a wrapped error, while keeping the decoded error in its chain.
+type decodedError struct {
+	msg string
+	err error
+}
+
+func (e *decodedError) Error() string {
+	return e.msg
+}
+
+func (e *decodedError) Unwrap() error {
+	return e.err
+}
+
+type sentinelCodec struct {
+	sentinel error
+}
+
+func (c sentinelCodec) Encode(err error) (json.RawMessage, bool, error) {
+	return nil, errors.Is(err, c.sentinel), nil
+}
+
+func (c sentinelCodec) Decode(json.RawMessage) (error, error) {
+	return c.sentinel, nil
+}
+
+type typeCodec[E error] struct{}
+
+func (typeCodec[E]) Encode(err error) (json.RawMessage, bool, error) {
+	var target E
+	if !errors.As(err, &target) {
+		return nil, false, nil
+	}
+
+	data, encErr := json.Marshal(target)
+	if encErr != nil {
+		return nil, false, encErr
+	}
+
+	return data, true, nil
+}
+
+func (typeCodec[E]) Decode(data json.RawMessage) (error, error) {
+	var target E
+
+	err := json.Unmarshal(data, &target)
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
@@ -0,0 +1,189 @@
+package st
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoFutures is the error returned by Race and Select when they are given no future to wait for.
+var ErrNoFutures = errors.New("no futures to wait for")
+
+// Go runs `f` in a new goroutine and returns a Future resolving to its result. A panic in `f` is recovered and
+// resolves the Future to an Err containing a *PanicError.
+func Go[T any](f func() (T, error)) *Future[T] {
+	return GoCtx(context.Background(), func(context.Context) (T, error) {
+		return f()
+	})
+}
+
+// GoCtx runs `f` in a new goroutine and returns a Future resolving to its result. `f` receives a context derived from
+// ctx, which is cancelled when the Future is cancelled with Future.Cancel. A panic in `f` is recovered and resolves
+// the Future to an Err containing a *PanicError.
+func GoCtx[T any](ctx context.Context, f func(ctx context.Context) (T, error)) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	fut := newFuture[T](cancel)
+
+	go func() {
+		defer cancel()
+
+		res := FlattenResult(CatchUnwind(func() *Result[T] {
+			return ResultOf(f(ctx))
+		}))
+
+		fut.resolve(res)
+	}()
+
+	return fut
+}
+
+// Ready returns a Future that is already resolved to the given Result.
+func Ready[T any](res *Result[T]) *Future[T] {
+	fut := newFuture[T](func() {})
+	fut.resolve(res)
+
+	return fut
+}
+
+// ThenFuture returns a Future that runs `f` with the value of `fut` once it resolves to an Ok. If `fut` resolves to an
+// Err, `f` isn't called and the returned Future resolves to that Err.
+func ThenFuture[T any, U any](fut *Future[T], f func(T) (U, error)) *Future[U] {
+	return GoCtx(context.Background(), func(ctx context.Context) (U, error) {
+		res := fut.Await(ctx)
+		if res.IsErr() {
+			var zero U
+
+			return zero, res.UnwrapErr()
+		}
+
+		return f(res.Unwrap())
+	})
+}
+
+// JoinAll returns a Future that resolves to the values of all the futures, in order, once they all resolve to an Ok.
+// It resolves to the first Err as soon as any of the futures does.
+func JoinAll[T any](futures ...*Future[T]) *Future[[]T] {
+	type indexed struct {
+		idx int
+		res *Result[T]
+	}
+
+	return GoCtx(context.Background(), func(ctx context.Context) ([]T, error) {
+		results := make(chan indexed, len(futures))
+
+		for idx, fut := range futures {
+			go func() {
+				results <- indexed{idx: idx, res: fut.Await(ctx)}
+			}()
+		}
+
+		values := make([]T, len(futures))
+
+		for range futures {
+			r := <-results
+			if r.res.IsErr() {
+				return nil, r.res.UnwrapErr()
+			}
+
+			values[r.idx] = r.res.Unwrap()
+		}
+
+		return values, nil
+	})
+}
+
+// Race returns a Future that resolves to the Result of the first of the futures to resolve, be it Ok or Err. It
+// resolves to an Err containing ErrNoFutures right away if there are no futures.
+func Race[T any](futures ...*Future[T]) *Future[T] {
+	if len(futures) == 0 {
+		return Ready(Err[T](ErrNoFutures))
+	}
+
+	return GoCtx(context.Background(), func(ctx context.Context) (T, error) {
+		_, res := Select(ctx, futures...)
+
+		return res.Expand()
+	})
+}
+
+// Select waits for the first of the futures to resolve, and returns its index and Result. If ctx is done first, it
+// returns -1 and an Err containing the context's error. If there are no futures, it returns -1 and an Err containing
+// ErrNoFutures right away.
+func Select[T any](ctx context.Context, futures ...*Future[T]) (int, *Result[T]) {
+	if len(futures) == 0 {
+		return -1, Err[T](ErrNoFutures)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	first := make(chan int, len(futures))
+
+	for idx, fut := range futures {
+		go func() {
+			select {
+			case <-fut.done:
+				first <- idx
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	select {
+	case idx := <-first:
+		return idx, futures[idx].res
+	case <-ctx.Done():
+		return -1, Err[T](ctx.Err())
+	}
+}
+
+// Future is a type that represents a Result that will be available once an asynchronous computation completes.
+type Future[T any] struct {
+	done   chan struct{}
+	res    *Result[T]
+	cancel context.CancelFunc
+}
+
+func newFuture[T any](cancel context.CancelFunc) *Future[T] {
+	return &Future[T]{
+		done:   make(chan struct{}),
+		res:    nil,
+		cancel: cancel,
+	}
+}
+
+func (f *Future[T]) resolve(res *Result[T]) {
+	f.res = res
+	close(f.done)
+}
+
+// Await waits for the Future to resolve and returns its Result. If ctx is done first, it returns an Err containing
+// the context's error, without cancelling the Future.
+func (f *Future[T]) Await(ctx context.Context) *Result[T] {
+	select {
+	case <-f.done:
+		return f.res
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// Poll returns the Result of the Future if it is resolved, or None if it is still pending.
+func (f *Future[T]) Poll() *Option[*Result[T]] {
+	select {
+	case <-f.done:
+		return Some(f.res)
+	default:
+		return None[*Result[T]]()
+	}
+}
+
+// Done returns a channel that is closed once the Future is resolved.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel cancels the context of the function computing the Future, if it was created with GoCtx. The Future still
+// resolves to whatever that function returns.
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
@@ -0,0 +1,204 @@
+package st
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGo_ResolvesToTheResult(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		val := fake.Int()
+
+		fut := Go(func() (int, error) { return val, nil })
+
+		assert.Equal(t, Ok(val), fut.Await(t.Context()))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		fut := Go(func() (int, error) { return 0, err })
+
+		assert.Equal(t, Err[int](err), fut.Await(t.Context()))
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		fut := Go(func() (int, error) { panic("boom") })
+
+		res := fut.Await(t.Context())
+
+		var p *PanicError
+		require.ErrorAs(t, res.UnwrapErr(), &p)
+		assert.Equal(t, "boom", p.Value)
+	})
+}
+
+func TestGoCtx_CancelsTheFunctionContext(t *testing.T) {
+	started := make(chan struct{})
+
+	fut := GoCtx(t.Context(), func(ctx context.Context) (int, error) {
+		close(started)
+		<-ctx.Done()
+
+		return 0, ctx.Err()
+	})
+
+	<-started
+	assert.True(t, fut.Poll().IsNone())
+
+	fut.Cancel()
+
+	assert.Equal(t, Err[int](context.Canceled), fut.Await(t.Context()))
+}
+
+func TestFuture_Await(t *testing.T) {
+	t.Run("ctx done", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		fut := Go(func() (int, error) {
+			<-release
+
+			return 1, nil
+		})
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond)
+		defer cancel()
+
+		assert.Equal(t, Err[int](context.DeadlineExceeded), fut.Await(ctx))
+	})
+
+	t.Run("multiple times", func(t *testing.T) {
+		fut := Ready(Ok(42))
+
+		assert.Equal(t, Ok(42), fut.Await(t.Context()))
+		assert.Equal(t, Ok(42), fut.Await(t.Context()))
+	})
+}
+
+func TestFuture_Poll(t *testing.T) {
+	release := make(chan struct{})
+
+	fut := Go(func() (int, error) {
+		<-release
+
+		return 42, nil
+	})
+
+	assert.Equal(t, None[*Result[int]](), fut.Poll())
+
+	close(release)
+	<-fut.Done()
+
+	assert.Equal(t, Some(Ok(42)), fut.Poll())
+}
+
+func TestThenFuture_ChainsOkValues(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		fut := ThenFuture(Ready(Ok(21)), func(v int) (int, error) { return v * 2, nil })
+
+		assert.Equal(t, Ok(42), fut.Await(t.Context()))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		fut := ThenFuture(Ready(Err[int](err)), func(int) (int, error) {
+			assert.Fail(t, "function should not have been called")
+
+			return 0, nil
+		})
+
+		assert.Equal(t, Err[int](err), fut.Await(t.Context()))
+	})
+}
+
+func TestJoinAll_ResolvesToAllValues(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		slow := Go(func() (int, error) {
+			time.Sleep(10 * time.Millisecond)
+
+			return 1, nil
+		})
+
+		fut := JoinAll(slow, Ready(Ok(2)), Ready(Ok(3)))
+
+		assert.Equal(t, Ok([]int{1, 2, 3}), fut.Await(t.Context()))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		pending := make(chan struct{})
+		defer close(pending)
+
+		never := Go(func() (int, error) {
+			<-pending
+
+			return 0, nil
+		})
+
+		fut := JoinAll(never, Ready(Err[int](err)))
+
+		assert.Equal(t, Err[[]int](err), fut.Await(t.Context()))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, Ok([]int{}), JoinAll[int]().Await(t.Context()))
+	})
+}
+
+func TestRace_ResolvesToTheFirstResult(t *testing.T) {
+	pending := make(chan struct{})
+	defer close(pending)
+
+	never := Go(func() (int, error) {
+		<-pending
+
+		return 0, nil
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		assert.Equal(t, Ok(2), Race(never, Ready(Ok(2))).Await(t.Context()))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		assert.Equal(t, Err[int](err), Race(never, Ready(Err[int](err))).Await(t.Context()))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, Err[int](ErrNoFutures), Race[int]().Await(t.Context()))
+	})
+}
+
+func TestSelect_ReturnsTheFirstResolvedFuture(t *testing.T) {
+	pending := make(chan struct{})
+	defer close(pending)
+
+	never := Go(func() (int, error) {
+		<-pending
+
+		return 0, nil
+	})
+
+	idx, res := Select(t.Context(), never, Ready(Ok(2)))
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, Ok(2), res)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	idx, res = Select(ctx, never)
+	assert.Equal(t, -1, idx)
+	assert.Equal(t, Err[int](context.Canceled), res)
+
+	idx, res = Select[int](t.Context())
+	assert.Equal(t, -1, idx)
+	assert.Equal(t, Err[int](ErrNoFutures), res)
+}
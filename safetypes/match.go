@@ -0,0 +1,93 @@
+package st
+
+import (
+	"errors"
+	"slices"
+)
+
+// MatchOption calls `some` with the contained value if the Option is Some, otherwise calls `none`, and returns the
+// result.
+func MatchOption[T any, U any](opt *Option[T], some func(T) U, none func() U) U {
+	if opt.IsNone() {
+		return none()
+	}
+
+	return some(opt.Unwrap())
+}
+
+// MatchResult calls `ok` with the contained value if the Result is Ok, otherwise calls `err` with the contained
+// error, and returns the result.
+func MatchResult[T any, U any](res *Result[T], ok func(T) U, err func(error) U) U {
+	if res.IsErr() {
+		return err(res.UnwrapErr())
+	}
+
+	return ok(res.Unwrap())
+}
+
+// ErrCase is an arm of MatchErr. It returns Some with the result of the arm if it matches the error, otherwise None.
+type ErrCase[U any] func(err error) *Option[U]
+
+// IsCase creates an ErrCase that matches errors for which errors.Is(err, target) is true.
+func IsCase[U any](target error, f func(error) U) ErrCase[U] {
+	return func(err error) *Option[U] {
+		if !errors.Is(err, target) {
+			return None[U]()
+		}
+
+		return Some(f(err))
+	}
+}
+
+// AsCase creates an ErrCase that matches errors for which errors.As finds an E in the chain. The arm is called with
+// the matched E.
+func AsCase[E error, U any](f func(E) U) ErrCase[U] {
+	return func(err error) *Option[U] {
+		var target E
+		if !errors.As(err, &target) {
+			return None[U]()
+		}
+
+		return Some(f(target))
+	}
+}
+
+// ErrMatcher dispatches an error to the first matching ErrCase. It is created with MatchErr, and evaluated with
+// Default.
+type ErrMatcher[U any] struct {
+	err   error
+	cases []ErrCase[U]
+}
+
+// MatchErr creates an ErrMatcher that dispatches err to the first matching case. The match is only evaluated once a
+// default arm is provided with ErrMatcher.Default, so that every error is handled.
+//
+//	msg := st.MatchErr(err,
+//		st.IsCase(ErrNotFound, func(error) string { return "not found" }),
+//		st.AsCase(func(e *ValidationError) string { return "invalid " + e.Field }),
+//	).Default(func(e error) string { return e.Error() })
+func MatchErr[U any](err error, cases ...ErrCase[U]) *ErrMatcher[U] {
+	return &ErrMatcher[U]{
+		err:   err,
+		cases: slices.Clone(cases),
+	}
+}
+
+// Case adds an arm to the ErrMatcher. Arms are tried in the order they were added.
+func (m *ErrMatcher[U]) Case(c ErrCase[U]) *ErrMatcher[U] {
+	m.cases = append(m.cases, c)
+
+	return m
+}
+
+// Default returns the result of the first matching arm, or calls `f` with the error if no arm matches.
+func (m *ErrMatcher[U]) Default(f func(error) U) U {
+	for _, c := range m.cases {
+		res := c(m.err)
+		if res.IsSome() {
+			return res.Unwrap()
+		}
+	}
+
+	return f(m.err)
+}
@@ -0,0 +1,116 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchOption_CallsTheMatchingArm(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		val := fake.Int()
+
+		none := func() string {
+			assert.Fail(t, "none should not have been called")
+
+			return ""
+		}
+
+		assert.Equal(t, strconv.Itoa(val), MatchOption(Some(val), strconv.Itoa, none))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		some := func(int) string {
+			assert.Fail(t, "some should not have been called")
+
+			return ""
+		}
+
+		def := fake.RandomStringWithLength(8)
+
+		assert.Equal(t, def, MatchOption(None[int](), some, func() string { return def }))
+	})
+}
+
+func TestMatchResult_CallsTheMatchingArm(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		val := fake.Int()
+
+		errFn := func(error) string {
+			assert.Fail(t, "err should not have been called")
+
+			return ""
+		}
+
+		assert.Equal(t, strconv.Itoa(val), MatchResult(Ok(val), strconv.Itoa, errFn))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		okFn := func(int) string {
+			assert.Fail(t, "ok should not have been called")
+
+			return ""
+		}
+
+		assert.Equal(t, err.Error(), MatchResult(Err[int](err), okFn, error.Error))
+	})
+}
+
+func TestMatchErr_DispatchesToTheFirstMatchingArm(t *testing.T) {
+	unexpected := func(error) string {
+		assert.Fail(t, "arm should not have been called")
+
+		return ""
+	}
+
+	match := func(err error) string {
+		return MatchErr(err,
+			IsCase(errNotFound, func(error) string { return "not found" }),
+			AsCase(func(e *ValidationError) string { return "invalid " + e.Field }),
+			IsCase(errNotFound, unexpected),
+		).Default(func(error) string { return "default" })
+	}
+
+	t.Run("is", func(t *testing.T) {
+		assert.Equal(t, "not found", match(fmt.Errorf("wrapped: %w", errNotFound)))
+	})
+
+	t.Run("as", func(t *testing.T) {
+		err := &ValidationError{Field: "email", Reason: "missing @"}
+
+		assert.Equal(t, "invalid email", match(fmt.Errorf("wrapped: %w", err)))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, "default", match(errors.New(fake.RandomStringWithLength(8))))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, "default", match(nil))
+	})
+
+	t.Run("case", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		res := MatchErr[string](err).
+			Case(IsCase(errNotFound, unexpected)).
+			Case(IsCase(err, func(e error) string { return e.Error() })).
+			Default(unexpected)
+
+		assert.Equal(t, err.Error(), res)
+	})
+
+	t.Run("case doesn't alter the given cases", func(t *testing.T) {
+		cases := make([]ErrCase[string], 1, 2)
+		cases[0] = IsCase(errNotFound, func(error) string { return "first" })
+
+		MatchErr(errNotFound, cases...).Case(IsCase(errNotFound, unexpected))
+
+		assert.Nil(t, cases[:2][1])
+	})
+}
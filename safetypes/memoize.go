@@ -0,0 +1,234 @@
+package st
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+var errNilMemoResult = errors.New("memoized function returned a nil Result")
+
+// MemoizeOption configures the cache of Memoize.
+type MemoizeOption func(*memoConfig)
+
+// WithTTL sets how long Ok results are cached, or forever if d <= 0. Defaults to forever.
+func WithTTL(d time.Duration) MemoizeOption {
+	return func(c *memoConfig) {
+		c.ttl = d
+	}
+}
+
+// WithErrTTL sets how long Err results are cached, or not at all if d <= 0. Defaults to not at all.
+func WithErrTTL(d time.Duration) MemoizeOption {
+	return func(c *memoConfig) {
+		c.errTTL = d
+	}
+}
+
+// WithMaxSize sets the maximum number of cached results, or no maximum if n <= 0. When the cache is full, the least
+// recently used result is evicted. Defaults to no maximum.
+func WithMaxSize(n int) MemoizeOption {
+	return func(c *memoConfig) {
+		c.maxSize = n
+	}
+}
+
+// WithMemoizeClock sets the Clock used to expire cached results. Defaults to SystemClock.
+func WithMemoizeClock(clock Clock) MemoizeOption {
+	return func(c *memoConfig) {
+		c.clock = clock
+	}
+}
+
+type memoConfig struct {
+	ttl     time.Duration
+	errTTL  time.Duration
+	maxSize int
+	clock   Clock
+}
+
+// MemoStats are the statistics of a Memoized function.
+type MemoStats struct {
+	// Hits is the number of calls served from the cache, or by waiting for an in-flight call with the same key.
+	Hits uint64
+	// Misses is the number of calls of the memoized function.
+	Misses uint64
+	// Evictions is the number of results evicted to keep the cache under its maximum size.
+	Evictions uint64
+	// Size is the number of cached results, including expired ones not removed yet.
+	Size int
+}
+
+// Memoize wraps `f` so that its results are cached per key. Concurrent calls with the same key are deduplicated:
+// only the first one calls `f`, and the others wait for its result.
+//
+// A panic in `f` is recovered and returned as an Err containing a *PanicError to all the waiting callers, and is never
+// cached.
+func Memoize[K comparable, V any](f func(K) *Result[V], opts ...MemoizeOption) *Memoized[K, V] {
+	cfg := memoConfig{
+		ttl:     0,
+		errTTL:  0,
+		maxSize: 0,
+		clock:   SystemClock,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &Memoized[K, V]{
+		f:       f,
+		cfg:     cfg,
+		mu:      sync.Mutex{},
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+		calls:   make(map[K]*memoCall[V]),
+		stats:   MemoStats{Hits: 0, Misses: 0, Evictions: 0, Size: 0},
+	}
+}
+
+// Memoized is a function whose results are cached, created with Memoize.
+type Memoized[K comparable, V any] struct {
+	f   func(K) *Result[V]
+	cfg memoConfig
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	lru     *list.List
+	calls   map[K]*memoCall[V]
+	stats   MemoStats
+}
+
+type memoEntry[K comparable, V any] struct {
+	key     K
+	res     *Result[V]
+	expires time.Time
+}
+
+type memoCall[V any] struct {
+	done chan struct{}
+	res  *Result[V]
+}
+
+// Get returns the cached result for the key if there is one, otherwise it calls the memoized function. A nil Result
+// returned by the function is turned into an Err, which isn't cached.
+func (m *Memoized[K, V]) Get(key K) *Result[V] {
+	m.mu.Lock()
+
+	if res := m.cached(key); res != nil {
+		m.stats.Hits++
+		m.mu.Unlock()
+
+		return res
+	}
+
+	if c, ok := m.calls[key]; ok {
+		m.stats.Hits++
+		m.mu.Unlock()
+		<-c.done
+
+		return c.res
+	}
+
+	c := &memoCall[V]{done: make(chan struct{}), res: nil}
+	m.calls[key] = c
+	m.stats.Misses++
+	m.mu.Unlock()
+
+	defer close(c.done)
+
+	caught := CatchUnwind(func() *Result[V] { return m.f(key) })
+	cache := caught.IsOk() && caught.Unwrap() != nil
+
+	c.res = FlattenResult(caught)
+	if c.res == nil {
+		c.res = Err[V](errNilMemoResult)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.calls, key)
+
+	if cache {
+		m.store(key, c.res)
+	}
+
+	return c.res
+}
+
+// Forget removes the cached result for the key, if any.
+func (m *Memoized[K, V]) Forget(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Stats returns the statistics of the cache.
+func (m *Memoized[K, V]) Stats() MemoStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := m.stats
+	stats.Size = m.lru.Len()
+
+	return stats
+}
+
+// cached returns the cached result for the key, or nil if there is none or it expired. Must be called with the lock
+// held.
+func (m *Memoized[K, V]) cached(key K) *Result[V] {
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil
+	}
+
+	entry := elem.Value.(*memoEntry[K, V]) //nolint:forcetypeassert // Only entries are stored
+	if !entry.expires.IsZero() && !m.cfg.clock.Now().Before(entry.expires) {
+		m.remove(elem)
+
+		return nil
+	}
+
+	m.lru.MoveToFront(elem)
+
+	return entry.res
+}
+
+// store caches the result, if its TTL allows it, and evicts the least recently used results if the cache is full.
+// Must be called with the lock held.
+func (m *Memoized[K, V]) store(key K, res *Result[V]) {
+	ttl := m.cfg.ttl
+	if res.IsErr() {
+		if m.cfg.errTTL <= 0 {
+			return
+		}
+
+		ttl = m.cfg.errTTL
+	}
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = m.cfg.clock.Now().Add(ttl)
+	}
+
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+
+	m.entries[key] = m.lru.PushFront(&memoEntry[K, V]{key: key, res: res, expires: expires})
+
+	for m.cfg.maxSize > 0 && m.lru.Len() > m.cfg.maxSize {
+		m.remove(m.lru.Back())
+		m.stats.Evictions++
+	}
+}
+
+func (m *Memoized[K, V]) remove(elem *list.Element) {
+	entry := elem.Value.(*memoEntry[K, V]) //nolint:forcetypeassert // Only entries are stored
+	m.lru.Remove(elem)
+	delete(m.entries, entry.key)
+}
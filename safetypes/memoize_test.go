@@ -0,0 +1,188 @@
+package st
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingLookup returns a lookup formatting its key, and the number of times it was called.
+func countingLookup() (func(int) *Result[string], *atomic.Int64) {
+	var calls atomic.Int64
+
+	return func(k int) *Result[string] {
+		calls.Add(1)
+
+		return Ok(strconv.Itoa(k))
+	}, &calls
+}
+
+func TestMemoize_CachesOkResults(t *testing.T) {
+	lookup, calls := countingLookup()
+	m := Memoize(lookup)
+
+	assert.Equal(t, Ok("1"), m.Get(1))
+	assert.Equal(t, Ok("1"), m.Get(1))
+	assert.Equal(t, Ok("2"), m.Get(2))
+
+	assert.Equal(t, int64(2), calls.Load())
+	assert.Equal(t, MemoStats{Hits: 1, Misses: 2, Evictions: 0, Size: 2}, m.Stats())
+}
+
+func TestMemoize_DedupesInFlightCalls(t *testing.T) {
+	const callers = 10
+
+	var calls atomic.Int64
+
+	release := make(chan struct{})
+	m := Memoize(func(k int) *Result[int] {
+		calls.Add(1)
+		<-release
+
+		return Ok(k * 2)
+	})
+
+	results := make([]*Result[int], callers)
+
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i] = m.Get(21)
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return m.Stats().Hits+m.Stats().Misses == callers
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load())
+
+	for _, res := range results {
+		assert.Equal(t, Ok(42), res)
+	}
+
+	assert.Equal(t, MemoStats{Hits: callers - 1, Misses: 1, Evictions: 0, Size: 1}, m.Stats())
+}
+
+func TestMemoize_ExpiresAfterTTL(t *testing.T) {
+	clock := newFakeClock()
+	lookup, calls := countingLookup()
+	m := Memoize(lookup, WithTTL(time.Minute), WithMemoizeClock(clock))
+
+	m.Get(1)
+	clock.Advance(59 * time.Second)
+	m.Get(1)
+	assert.Equal(t, int64(1), calls.Load())
+
+	clock.Advance(time.Second)
+	m.Get(1)
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestMemoize_CachesErrResults(t *testing.T) {
+	var calls int
+
+	err := errors.New(fake.RandomStringWithLength(8))
+	failing := func(int) *Result[string] {
+		calls++
+
+		return Err[string](err)
+	}
+
+	t.Run("not by default", func(t *testing.T) {
+		calls = 0
+		m := Memoize(failing)
+
+		assert.Equal(t, Err[string](err), m.Get(1))
+		assert.Equal(t, Err[string](err), m.Get(1))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("with negative TTL", func(t *testing.T) {
+		calls = 0
+		clock := newFakeClock()
+		m := Memoize(failing, WithTTL(time.Hour), WithErrTTL(time.Second), WithMemoizeClock(clock))
+
+		assert.Equal(t, Err[string](err), m.Get(1))
+		assert.Equal(t, Err[string](err), m.Get(1))
+		assert.Equal(t, 1, calls)
+
+		clock.Advance(time.Second)
+		m.Get(1)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestMemoize_EvictsLeastRecentlyUsed(t *testing.T) {
+	lookup, calls := countingLookup()
+	m := Memoize(lookup, WithMaxSize(2))
+
+	m.Get(1)
+	m.Get(2)
+	m.Get(1)
+	m.Get(3) // Evicts 2
+
+	assert.Equal(t, MemoStats{Hits: 1, Misses: 3, Evictions: 1, Size: 2}, m.Stats())
+
+	m.Get(1)
+	m.Get(3)
+	assert.Equal(t, int64(3), calls.Load())
+
+	m.Get(2)
+	assert.Equal(t, int64(4), calls.Load())
+}
+
+func TestMemoize_Forget(t *testing.T) {
+	lookup, calls := countingLookup()
+	m := Memoize(lookup)
+
+	m.Get(1)
+	m.Forget(1)
+	m.Forget(2)
+	m.Get(1)
+
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestMemoize_RecoversPanics(t *testing.T) {
+	calls := 0
+	m := Memoize(func(int) *Result[int] {
+		calls++
+		panic("boom")
+	})
+
+	res := m.Get(1)
+
+	var p *PanicError
+	require.ErrorAs(t, res.UnwrapErr(), &p)
+	assert.Equal(t, "boom", p.Value)
+
+	m.Get(1)
+	assert.Equal(t, 2, calls)
+}
+
+func TestMemoize_NilResult(t *testing.T) {
+	calls := 0
+	m := Memoize(func(int) *Result[int] {
+		calls++
+
+		return nil
+	})
+
+	assert.Equal(t, Err[int](errNilMemoResult), m.Get(1))
+	assert.Equal(t, Err[int](errNilMemoResult), m.Get(1))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 0, m.Stats().Size)
+}
@@ -0,0 +1,162 @@
+package st
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrPoisoned is the error returned when locking a poisoned lock, i.e. a lock with poisoning enabled whose critical
+// section panicked.
+var ErrPoisoned = errors.New("lock poisoned: a previous critical section panicked")
+
+// LockOption configures a Mutex or a RwLock.
+type LockOption func(*poison)
+
+// WithPoisoning makes the lock poisoned when a critical section panics: all following locks return ErrPoisoned,
+// since the value may have been left in an inconsistent state.
+func WithPoisoning() LockOption {
+	return func(p *poison) {
+		p.enabled = true
+	}
+}
+
+type poison struct {
+	enabled  bool
+	poisoned atomic.Bool
+}
+
+func (p *poison) apply(opts []LockOption) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+func (p *poison) check() error {
+	if p.poisoned.Load() {
+		return ErrPoisoned
+	}
+
+	return nil
+}
+
+// run calls `f`, poisoning the lock if it doesn't return normally.
+func (p *poison) run(f func()) {
+	done := false
+
+	defer func() {
+		if !done && p.enabled {
+			p.poisoned.Store(true)
+		}
+	}()
+
+	f()
+
+	done = true
+}
+
+// NewMutex creates a Mutex protecting the value.
+func NewMutex[T any](val T, opts ...LockOption) *Mutex[T] {
+	m := &Mutex[T]{
+		mu:     sync.Mutex{},
+		val:    val,
+		poison: poison{enabled: false, poisoned: atomic.Bool{}},
+	}
+	m.poison.apply(opts)
+
+	return m
+}
+
+// WithLock calls `f` with the value of the Mutex while holding the lock, and returns its result as an Ok. It returns
+// an Err containing ErrPoisoned without calling `f` if the Mutex is poisoned.
+//
+// The pointer passed to `f` must not be retained after `f` returns.
+func WithLock[T any, U any](m *Mutex[T], f func(*T) U) *Result[U] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	err := m.poison.check()
+	if err != nil {
+		return Err[U](err)
+	}
+
+	var res U
+
+	m.poison.run(func() { res = f(&m.val) })
+
+	return Ok(res)
+}
+
+// Mutex is a mutual exclusion lock that owns the value it protects, like Rust's `Mutex`. The value can only be
+// accessed while holding the lock.
+//
+// Like sync.Mutex, the zero value is an unlocked Mutex holding the zero value of T, without poisoning, and a Mutex
+// must not be copied after first use.
+type Mutex[T any] struct {
+	mu     sync.Mutex
+	val    T
+	poison poison
+}
+
+// Lock calls `f` with the value of the Mutex while holding the lock, like WithLock for a function without result. It
+// returns an Err containing ErrPoisoned without calling `f` if the Mutex is poisoned.
+//
+// The pointer passed to `f` must not be retained after `f` returns.
+func (m *Mutex[T]) Lock(f func(*T)) *Result[struct{}] {
+	return WithLock(m, func(val *T) struct{} {
+		f(val)
+
+		return struct{}{}
+	})
+}
+
+// TryLock acquires the lock without blocking. It returns an Ok of a guard giving access to the value, an Ok of None if
+// the lock is already held, or an Err containing ErrPoisoned if the Mutex is poisoned. The guard must be unlocked once
+// done with the value.
+//
+// Unlike Lock, a panic while holding the guard doesn't poison the Mutex.
+func (m *Mutex[T]) TryLock() *Result[*Option[*MutexGuard[T]]] {
+	if !m.mu.TryLock() {
+		return Ok(None[*MutexGuard[T]]())
+	}
+
+	err := m.poison.check()
+	if err != nil {
+		m.mu.Unlock()
+
+		return Err[*Option[*MutexGuard[T]]](err)
+	}
+
+	return Ok(Some(&MutexGuard[T]{m: m, unlocked: false}))
+}
+
+// IsPoisoned returns true if the Mutex is poisoned.
+func (m *Mutex[T]) IsPoisoned() bool {
+	return m.poison.poisoned.Load()
+}
+
+// ClearPoison clears the poisoned state of the Mutex, once the value has been checked or fixed.
+func (m *Mutex[T]) ClearPoison() {
+	m.poison.poisoned.Store(false)
+}
+
+// MutexGuard gives access to the value of a Mutex locked with Mutex.TryLock.
+type MutexGuard[T any] struct {
+	m        *Mutex[T]
+	unlocked bool
+}
+
+// Value returns a pointer to the value of the Mutex. It must not be used after the guard is unlocked.
+func (g *MutexGuard[T]) Value() *T {
+	return &g.m.val
+}
+
+// Unlock releases the lock. Calling it more than once has no effect.
+func (g *MutexGuard[T]) Unlock() {
+	if g.unlocked {
+		return
+	}
+
+	g.unlocked = true
+	g.m.mu.Unlock()
+}
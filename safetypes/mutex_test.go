@@ -0,0 +1,90 @@
+package st
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type counter struct {
+	n int
+}
+
+func TestMutex_Lock(t *testing.T) {
+	m := NewMutex(counter{n: 0})
+
+	concurrently(100, func(int) {
+		assert.Equal(t, Ok(struct{}{}), m.Lock(func(c *counter) { c.n++ }))
+	})
+
+	assert.Equal(t, Ok(100), WithLock(m, func(c *counter) int { return c.n }))
+}
+
+func TestMutex_ZeroValue(t *testing.T) {
+	var s struct {
+		hits Mutex[counter]
+	}
+
+	require.True(t, s.hits.Lock(func(c *counter) { c.n++ }).IsOk())
+
+	guard := s.hits.TryLock().Unwrap()
+	require.True(t, guard.IsSome())
+	assert.Equal(t, 1, guard.Unwrap().Value().n)
+	guard.Unwrap().Unlock()
+
+	assert.False(t, s.hits.IsPoisoned())
+}
+
+func TestMutex_TryLock(t *testing.T) {
+	m := NewMutex(counter{n: 0})
+
+	guard := m.TryLock().Unwrap()
+	require.True(t, guard.IsSome())
+
+	assert.Equal(t, Ok(None[*MutexGuard[counter]]()), m.TryLock())
+
+	guard.Unwrap().Value().n = 42
+	guard.Unwrap().Unlock()
+	guard.Unwrap().Unlock()
+
+	other := m.TryLock().Unwrap()
+	require.True(t, other.IsSome())
+	assert.Equal(t, 42, other.Unwrap().Value().n)
+	other.Unwrap().Unlock()
+}
+
+func TestMutex_Poisoning(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		m := NewMutex(counter{n: 0})
+
+		assert.Panics(t, func() {
+			_ = m.Lock(func(*counter) { panic("boom") })
+		})
+
+		assert.False(t, m.IsPoisoned())
+		assert.Equal(t, Ok(0), WithLock(m, func(c *counter) int { return c.n }))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		m := NewMutex(counter{n: 0}, WithPoisoning())
+
+		assert.PanicsWithValue(t, "boom", func() {
+			_ = WithLock(m, func(c *counter) int {
+				c.n = -1
+				panic("boom")
+			})
+		})
+
+		assert.True(t, m.IsPoisoned())
+		assert.Equal(t, Err[struct{}](ErrPoisoned), m.Lock(func(*counter) {
+			assert.Fail(t, "function should not have been called")
+		}))
+		assert.Equal(t, Err[int](ErrPoisoned), WithLock(m, func(c *counter) int { return c.n }))
+		assert.Equal(t, Err[*Option[*MutexGuard[counter]]](ErrPoisoned), m.TryLock())
+
+		m.ClearPoison()
+
+		assert.Equal(t, Ok(-1), WithLock(m, func(c *counter) int { return c.n }))
+	})
+}
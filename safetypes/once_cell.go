@@ -0,0 +1,108 @@
+package st
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrAlreadySet is the error returned by OnceCell.Set when the cell is already set.
+var ErrAlreadySet = errors.New("cell already set")
+
+// OnceCell is a cell that can be set only once, like Rust's `OnceLock`. The zero value is an empty cell, ready to use.
+// It is safe for concurrent use, and must not be copied after first use.
+type OnceCell[T any] struct {
+	set atomic.Bool
+	mu  sync.Mutex
+	val T
+}
+
+// Get returns the value of the cell if it is set, or None otherwise.
+func (c *OnceCell[T]) Get() *Option[T] {
+	if !c.set.Load() {
+		return None[T]()
+	}
+
+	return Some(c.val)
+}
+
+// GetOrInit returns the value of the cell, setting it with `f` if the cell is empty. Concurrent callers wait for
+// the first one to call `f`. If `f` panics, the panic is propagated and the cell is left empty.
+func (c *OnceCell[T]) GetOrInit(f func() T) T {
+	if c.set.Load() {
+		return c.val
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.set.Load() {
+		c.val = f()
+		c.set.Store(true)
+	}
+
+	return c.val
+}
+
+// GetOrTryInit returns the value of the cell, setting it with `f` if the cell is empty. If `f` returns an Err, the
+// cell is left empty and the Err is returned, so that the next call tries again.
+func (c *OnceCell[T]) GetOrTryInit(f func() *Result[T]) *Result[T] {
+	if c.set.Load() {
+		return Ok(c.val)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.set.Load() {
+		return Ok(c.val)
+	}
+
+	res := f()
+	if res.IsOk() {
+		c.val = res.Unwrap()
+		c.set.Store(true)
+	}
+
+	return res
+}
+
+// Set sets the value of the cell if it is empty and returns an Ok of the value, or an Err containing ErrAlreadySet if
+// the cell is already set.
+func (c *OnceCell[T]) Set(val T) *Result[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.set.Load() {
+		return Err[T](ErrAlreadySet)
+	}
+
+	c.val = val
+	c.set.Store(true)
+
+	return Ok(val)
+}
+
+// NewLazy creates a Lazy value computed with `f` on first access.
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{
+		cell: OnceCell[T]{}, //nolint:exhaustruct // The zero value is an empty cell
+		init: f,
+	}
+}
+
+// Lazy is a value computed on first access, like Rust's `LazyLock`. It is meant for package-level values:
+//
+//	var config = st.NewLazy(loadConfig)
+//
+// It is safe for concurrent use.
+type Lazy[T any] struct {
+	cell OnceCell[T]
+	init func() T
+}
+
+// Get returns the value, computing it if this is the first access. If the computation panics, the panic is
+// propagated and the next access tries again.
+func (l *Lazy[T]) Get() T {
+	return l.cell.GetOrInit(l.init)
+}
@@ -0,0 +1,166 @@
+package st
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// concurrently calls `f` from n goroutines at once, and waits for all of them.
+func concurrently(n int, f func(i int)) {
+	var wg sync.WaitGroup
+
+	start := make(chan struct{})
+
+	for i := range n {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			<-start
+
+			f(i)
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+}
+
+func TestOnceCell_Get(t *testing.T) {
+	var cell OnceCell[int]
+
+	assert.Equal(t, None[int](), cell.Get())
+
+	cell.Set(42)
+
+	assert.Equal(t, Some(42), cell.Get())
+}
+
+func TestOnceCell_GetOrInit(t *testing.T) {
+	t.Run("initializes once", func(t *testing.T) {
+		var (
+			cell  OnceCell[int]
+			calls atomic.Int64
+		)
+
+		results := make([]int, 50)
+
+		concurrently(len(results), func(i int) {
+			results[i] = cell.GetOrInit(func() int {
+				calls.Add(1)
+
+				return i + 1
+			})
+		})
+
+		assert.Equal(t, int64(1), calls.Load())
+
+		for _, res := range results {
+			assert.Equal(t, results[0], res)
+		}
+
+		assert.Equal(t, Some(results[0]), cell.Get())
+	})
+
+	t.Run("panic leaves the cell empty", func(t *testing.T) {
+		var cell OnceCell[int]
+
+		assert.PanicsWithValue(t, "boom", func() {
+			cell.GetOrInit(func() int { panic("boom") })
+		})
+		assert.True(t, cell.Get().IsNone())
+
+		assert.Equal(t, 1, cell.GetOrInit(func() int { return 1 }))
+	})
+}
+
+func TestOnceCell_GetOrTryInit(t *testing.T) {
+	t.Run("retries on failure", func(t *testing.T) {
+		var cell OnceCell[string]
+
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		assert.Equal(t, Err[string](err), cell.GetOrTryInit(func() *Result[string] {
+			return Err[string](err)
+		}))
+		assert.True(t, cell.Get().IsNone())
+
+		assert.Equal(t, Ok("value"), cell.GetOrTryInit(func() *Result[string] {
+			return Ok("value")
+		}))
+		assert.Equal(t, Ok("value"), cell.GetOrTryInit(func() *Result[string] {
+			assert.Fail(t, "function should not have been called")
+
+			return Ok("other")
+		}))
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var (
+			cell  OnceCell[int]
+			calls atomic.Int64
+		)
+
+		concurrently(50, func(i int) {
+			res := cell.GetOrTryInit(func() *Result[int] {
+				// The first 5 initializations fail
+				if calls.Add(1) <= 5 {
+					return Err[int](errNotFound)
+				}
+
+				return Ok(i)
+			})
+
+			if res.IsOk() {
+				assert.Equal(t, cell.Get(), res.AsOptionValue())
+			}
+		})
+
+		assert.Equal(t, int64(6), calls.Load())
+		assert.True(t, cell.Get().IsSome())
+	})
+}
+
+func TestOnceCell_Set(t *testing.T) {
+	var (
+		cell OnceCell[int]
+		oks  atomic.Int64
+	)
+
+	concurrently(50, func(i int) {
+		res := cell.Set(i)
+		if res.IsOk() {
+			oks.Add(1)
+
+			return
+		}
+
+		assert.Same(t, ErrAlreadySet, res.UnwrapErr())
+	})
+
+	assert.Equal(t, int64(1), oks.Load())
+	require.True(t, cell.Get().IsSome())
+}
+
+func TestLazy_ComputesOnFirstAccess(t *testing.T) {
+	var calls atomic.Int64
+
+	lazy := NewLazy(func() string {
+		calls.Add(1)
+
+		return "value"
+	})
+
+	assert.Equal(t, int64(0), calls.Load())
+
+	concurrently(50, func(int) {
+		assert.Equal(t, "value", lazy.Get())
+	})
+
+	assert.Equal(t, int64(1), calls.Load())
+}
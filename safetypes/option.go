@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+
+	"github.com/RogueConsultingDev/grust/it"
 )
 
 // None creates a None variant of Option.
@@ -80,20 +82,74 @@ func AndThen[T any, U any](opt *Option[T], f func(T) *Option[U]) *Option[U] {
 	return f(opt.Unwrap())
 }
 
+// ZipOption zips two Options together. Returns Some((a, b)) if both Options are Some, otherwise returns None.
+func ZipOption[T any, U any](opt *Option[T], other *Option[U]) *Option[it.Tuple[T, U]] {
+	if opt.IsNone() || other.IsNone() {
+		return None[it.Tuple[T, U]]()
+	}
+
+	return Some(it.Tuple[T, U]{A: opt.Unwrap(), B: other.Unwrap()})
+}
+
+// UnzipOption unzips an Option containing a tuple into a tuple of Options.
+func UnzipOption[T any, U any](opt *Option[it.Tuple[T, U]]) (*Option[T], *Option[U]) {
+	if opt.IsNone() {
+		return None[T](), None[U]()
+	}
+
+	t := opt.Unwrap()
+
+	return Some(t.A), Some(t.B)
+}
+
+// FlattenOption converts from Option<Option<T>> to Option<T>.
+func FlattenOption[T any](opt *Option[*Option[T]]) *Option[T] {
+	if opt.IsNone() {
+		return None[T]()
+	}
+
+	return opt.Unwrap()
+}
+
+// OptionContains returns true if the Option is a Some value containing the given value.
+func OptionContains[T comparable](opt *Option[T], val T) bool {
+	return opt.IsSomeAnd(func(v T) bool { return v == val })
+}
+
+// CopiedOption maps an Option<*T> to an Option<T> by copying the contents of the Option. Panics if the Option
+// contains a nil pointer.
+func CopiedOption[T any](opt *Option[*T]) *Option[T] {
+	if opt.IsNone() {
+		return None[T]()
+	}
+
+	return Some(*opt.Unwrap())
+}
+
 // Option is a type that represents either a value (Some) or not (None).
+//
+// A nil *Option, which encoding/json leaves in *Option[T] fields for a missing or null value, is a None: all methods
+// that don't modify the Option can be called on it. Insert, Replace and the GetOrInsert methods panic on a nil
+// Option, since they have nowhere to store the value. Note that encoding/json encodes a nil Option as null, but fmt
+// prints it as <nil> rather than None.
 type Option[T any] struct {
 	ok  bool
 	val T
 }
 
+// isSome returns true if the Option is a Some value, treating a nil Option as a None.
+func (o *Option[T]) isSome() bool {
+	return o != nil && o.ok
+}
+
 // IsNone returns true if the Option is a None value.
 func (o *Option[T]) IsNone() bool {
-	return !o.ok
+	return !o.isSome()
 }
 
 // IsNoneOr returns true if the Option is a None or the value inside of it matches a predicate.
 func (o *Option[T]) IsNoneOr(f func(T) bool) bool {
-	if !o.ok {
+	if !o.isSome() {
 		return true
 	}
 
@@ -102,12 +158,12 @@ func (o *Option[T]) IsNoneOr(f func(T) bool) bool {
 
 // IsSome returns true if the Option is a Some value.
 func (o *Option[T]) IsSome() bool {
-	return o.ok
+	return o.isSome()
 }
 
 // IsSomeAnd returns true if the Option is a Some and the value inside of it matches a predicate.
 func (o *Option[T]) IsSomeAnd(f func(T) bool) bool {
-	if !o.ok {
+	if !o.isSome() {
 		return false
 	}
 
@@ -117,25 +173,25 @@ func (o *Option[T]) IsSomeAnd(f func(T) bool) bool {
 // Expect returns the contained Some value, consuming the self value. Panics if the value is a None with a custom
 // panic message provided by msg.
 func (o *Option[T]) Expect(msg string) T {
-	if o.ok {
+	if o.isSome() {
 		return o.val
 	}
 
-	panic(errors.New(msg))
+	panic(newUnwrapPanic(errors.New(msg)))
 }
 
 // Unwrap returns the contained Some value, consuming the self value. Panics if the self value equals None.
 func (o *Option[T]) Unwrap() T {
-	if o.ok {
+	if o.isSome() {
 		return o.val
 	}
 
-	panic(errors.New("called `Option.Unwrap()` on a `None` value"))
+	panic(newUnwrapPanic(errors.New("called `Option.Unwrap()` on a `None` value")))
 }
 
 // UnwrapOr returns the contained Some value or a provided default.
 func (o *Option[T]) UnwrapOr(def T) T {
-	if o.ok {
+	if o.isSome() {
 		return o.val
 	}
 
@@ -144,7 +200,7 @@ func (o *Option[T]) UnwrapOr(def T) T {
 
 // UnwrapOrElse returns the contained Some value or computes it from a closure.
 func (o *Option[T]) UnwrapOrElse(f func() T) T {
-	if o.ok {
+	if o.isSome() {
 		return o.val
 	}
 
@@ -153,7 +209,7 @@ func (o *Option[T]) UnwrapOrElse(f func() T) T {
 
 // UnwrapOrDefault returns the contained Some value or a default.
 func (o *Option[T]) UnwrapOrDefault() T {
-	if o.ok {
+	if o.isSome() {
 		return o.val
 	}
 
@@ -164,7 +220,7 @@ func (o *Option[T]) UnwrapOrDefault() T {
 
 // AsOkOr converts an Option to an Ok when opt is Some or Err when opt is None.
 func (o *Option[T]) AsOkOr(err error) *Result[T] {
-	if o.ok {
+	if o.isSome() {
 		return Ok[T](o.val)
 	}
 
@@ -173,7 +229,7 @@ func (o *Option[T]) AsOkOr(err error) *Result[T] {
 
 // AsOkOrElse converts an Option to an Ok when opt is Some or Err when opt is None.
 func (o *Option[T]) AsOkOrElse(f func() error) *Result[T] {
-	if o.ok {
+	if o.isSome() {
 		return Ok[T](o.val)
 	}
 
@@ -182,7 +238,7 @@ func (o *Option[T]) AsOkOrElse(f func() error) *Result[T] {
 
 // Inspect calls a function with a reference to the contained value if Some. Returns the original Option.
 func (o *Option[T]) Inspect(f func(T)) *Option[T] {
-	if o.ok {
+	if o.isSome() {
 		f(o.val)
 	}
 
@@ -193,7 +249,7 @@ func (o *Option[T]) Inspect(f func(T)) *Option[T] {
 //   - Some(t) if predicate returns true (where t is the wrapped value), and
 //   - None if predicate returns false.
 func (o *Option[T]) Filter(f func(T) bool) *Option[T] {
-	if o.ok && f(o.val) {
+	if o.isSome() && f(o.val) {
 		return o
 	}
 
@@ -202,7 +258,7 @@ func (o *Option[T]) Filter(f func(T) bool) *Option[T] {
 
 // Or returns the Option if it contains a value, otherwise returns optb.
 func (o *Option[T]) Or(other *Option[T]) *Option[T] {
-	if o.ok {
+	if o.isSome() {
 		return o
 	}
 
@@ -211,7 +267,7 @@ func (o *Option[T]) Or(other *Option[T]) *Option[T] {
 
 // OrElse returns the option if it contains a value, otherwise calls f and returns the result.
 func (o *Option[T]) OrElse(f func() *Option[T]) *Option[T] {
-	if o.ok {
+	if o.isSome() {
 		return o
 	}
 
@@ -220,11 +276,11 @@ func (o *Option[T]) OrElse(f func() *Option[T]) *Option[T] {
 
 // Xor returns Some if exactly one of self, optb is Some, otherwise returns None.
 func (o *Option[T]) Xor(other *Option[T]) *Option[T] {
-	if o.ok && other.IsNone() {
+	if o.isSome() && other.IsNone() {
 		return o
 	}
 
-	if !o.ok && other.IsSome() {
+	if !o.isSome() && other.IsSome() {
 		return other
 	}
 
@@ -287,7 +343,7 @@ func (o *Option[T]) GetOrInsertWith(f func() T) *T {
 
 // Take takes the value out of the Option, leaving a None in its place.
 func (o *Option[T]) Take() *Option[T] {
-	if o.ok {
+	if o.isSome() {
 		res := *o
 
 		o.ok = false
@@ -298,13 +354,24 @@ func (o *Option[T]) Take() *Option[T] {
 	return o
 }
 
+// Replace replaces the actual value in the Option by the value given in parameter, returning the old value if
+// present, leaving a Some in its place.
+func (o *Option[T]) Replace(val T) *Option[T] {
+	old := *o
+
+	o.ok = true
+	o.val = val
+
+	return &old
+}
+
 // TakeIf takes the value out of the Option, but only if the predicate evaluates to true on a mutable reference to
 // the value.
 //
 // In other words, replaces self with None if the predicate returns true. This method operates similar to take but
 // conditional.
 func (o *Option[T]) TakeIf(f func(T) bool) *Option[T] {
-	if o.ok && f(o.val) {
+	if o.isSome() && f(o.val) {
 		res := *o
 
 		o.ok = false
@@ -316,7 +383,7 @@ func (o *Option[T]) TakeIf(f func(T) bool) *Option[T] {
 }
 
 func (o *Option[T]) String() string {
-	if o.ok {
+	if o.isSome() {
 		return fmt.Sprintf("Some(%v)", o.val)
 	}
 
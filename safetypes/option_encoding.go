@@ -0,0 +1,241 @@
+package st
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+const (
+	binaryNone byte = iota
+	binarySome
+)
+
+var errInvalidBinary = errors.New("invalid binary data")
+
+// MarshalText implements encoding.TextMarshaler. A None is encoded as an empty text, a Some value is encoded as its
+// contained value with encoding.TextMarshaler if T implements it, otherwise as the text of a string, bool, number or
+// time.Duration.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.ok {
+		return []byte{}, nil
+	}
+
+	return marshalText(o.val)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty text is decoded as None, any other text is decoded into
+// T, as described in MarshalText, and wrapped in Some.
+//
+// Note that Some of an empty string can't be represented, since it is decoded as None.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	var v T
+
+	if len(text) > 0 {
+		err := unmarshalText(text, &v)
+		if err != nil {
+			return err
+		}
+	}
+
+	o.ok = len(text) > 0
+	o.val = v
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is a byte telling whether the Option is a Some,
+// followed by the contained value encoded with encoding.BinaryMarshaler if T implements it, otherwise with encoding/gob.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	if !o.ok {
+		return []byte{binaryNone}, nil
+	}
+
+	data, err := marshalBinary(o.val)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binarySome}, data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. See MarshalBinary for the expected format.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	return o.decodeTagged(data, unmarshalBinary)
+}
+
+// GobEncode implements gob.GobEncoder. The encoding is a byte telling whether the Option is a Some, followed by the
+// contained value encoded with encoding/gob, which itself uses the gob.GobEncoder or encoding.BinaryMarshaler
+// implementation of T if there is one.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) GobEncode() ([]byte, error) {
+	if !o.ok {
+		return []byte{binaryNone}, nil
+	}
+
+	data, err := gobEncode(o.val)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binarySome}, data...), nil
+}
+
+// GobDecode implements gob.GobDecoder. See GobEncode for the expected format.
+func (o *Option[T]) GobDecode(data []byte) error {
+	return o.decodeTagged(data, gobDecode)
+}
+
+func (o *Option[T]) decodeTagged(data []byte, decode func([]byte, any) error) error {
+	var v T
+
+	if len(data) == 0 {
+		return errInvalidBinary
+	}
+
+	switch data[0] {
+	case binaryNone:
+		o.ok = false
+	case binarySome:
+		err := decode(data[1:], &v)
+		if err != nil {
+			return err
+		}
+
+		o.ok = true
+	default:
+		return fmt.Errorf("%w: unknown Option tag %d", errInvalidBinary, data[0])
+	}
+
+	o.val = v
+
+	return nil
+}
+
+func marshalBinary[T any](val T) ([]byte, error) {
+	if m, ok := any(&val).(encoding.BinaryMarshaler); ok {
+		return m.MarshalBinary()
+	}
+
+	return gobEncode(val)
+}
+
+func unmarshalBinary(data []byte, dst any) error {
+	if u, ok := dst.(encoding.BinaryUnmarshaler); ok {
+		return u.UnmarshalBinary(data)
+	}
+
+	return gobDecode(data, dst)
+}
+
+func gobEncode[T any](val T) ([]byte, error) {
+	var buf bytes.Buffer
+
+	// Encode through a pointer so nested types are encoded with their own GobEncode.
+	err := gob.NewEncoder(&buf).Encode(&val)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func gobDecode(data []byte, dst any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(dst)
+}
+
+func marshalText[T any](val T) ([]byte, error) {
+	if m, ok := any(&val).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	if d, ok := any(val).(time.Duration); ok {
+		return []byte(d.String()), nil
+	}
+
+	v := reflect.ValueOf(&val).Elem()
+
+	switch v.Kind() { //nolint:exhaustive // Other kinds have no text representation
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("type %s can't be encoded as text", v.Type())
+	}
+}
+
+func unmarshalText[T any](text []byte, dst *T) error {
+	if u, ok := any(dst).(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+
+	if d, ok := any(dst).(*time.Duration); ok {
+		parsed, err := time.ParseDuration(string(text))
+		if err != nil {
+			return err
+		}
+
+		*d = parsed
+
+		return nil
+	}
+
+	v := reflect.ValueOf(dst).Elem()
+	s := string(text)
+
+	switch v.Kind() { //nolint:exhaustive // Other kinds have no text representation
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("type %s can't be decoded from text", v.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package st
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upper is a type with its own text and binary encodings, which upper-case the value.
+type upper struct {
+	s string
+}
+
+func (u upper) MarshalText() ([]byte, error) {
+	return bytes.ToUpper([]byte(u.s)), nil
+}
+
+func (u *upper) UnmarshalText(text []byte) error {
+	u.s = string(bytes.ToLower(text))
+
+	return nil
+}
+
+func (u upper) MarshalBinary() ([]byte, error) {
+	return u.MarshalText()
+}
+
+func (u *upper) UnmarshalBinary(data []byte) error {
+	return u.UnmarshalText(data)
+}
+
+func textRoundTrip[T any](t *testing.T, o *Option[T]) (string, *Option[T]) {
+	t.Helper()
+
+	text, err := o.MarshalText()
+	require.NoError(t, err)
+
+	var out Option[T]
+	require.NoError(t, out.UnmarshalText(text))
+
+	return string(text), &out
+}
+
+func TestOption_MarshalText(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
+		text, out := textRoundTrip(t, None[int]())
+
+		assert.Empty(t, text)
+		assert.Equal(t, None[int](), out)
+	})
+
+	t.Run("basic types", func(t *testing.T) {
+		text, out := textRoundTrip(t, Some("str"))
+		assert.Equal(t, "str", text)
+		assert.Equal(t, Some("str"), out)
+
+		text, outInt := textRoundTrip(t, Some(int8(-42)))
+		assert.Equal(t, "-42", text)
+		assert.Equal(t, Some(int8(-42)), outInt)
+
+		text, outUint := textRoundTrip(t, Some(uint(42)))
+		assert.Equal(t, "42", text)
+		assert.Equal(t, Some(uint(42)), outUint)
+
+		text, outFloat := textRoundTrip(t, Some(1.5))
+		assert.Equal(t, "1.5", text)
+		assert.Equal(t, Some(1.5), outFloat)
+
+		text, outBool := textRoundTrip(t, Some(true))
+		assert.Equal(t, "true", text)
+		assert.Equal(t, Some(true), outBool)
+
+		text, outDuration := textRoundTrip(t, Some(90*time.Second))
+		assert.Equal(t, "1m30s", text)
+		assert.Equal(t, Some(90*time.Second), outDuration)
+	})
+
+	t.Run("TextMarshaler", func(t *testing.T) {
+		text, out := textRoundTrip(t, Some(upper{s: "value"}))
+		assert.Equal(t, "VALUE", text)
+		assert.Equal(t, Some(upper{s: "value"}), out)
+
+		addr := netip.MustParseAddr("192.168.1.1")
+		text, outAddr := textRoundTrip(t, Some(addr))
+		assert.Equal(t, "192.168.1.1", text)
+		assert.Equal(t, Some(addr), outAddr)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := Some([]int{1}).MarshalText()
+		require.EqualError(t, err, "type []int can't be encoded as text")
+
+		var o Option[[]int]
+		require.EqualError(t, o.UnmarshalText([]byte("1")), "type []int can't be decoded from text")
+	})
+
+	t.Run("invalid text", func(t *testing.T) {
+		var o Option[int]
+		require.Error(t, o.UnmarshalText([]byte("abc")))
+
+		var d Option[time.Duration]
+		require.Error(t, d.UnmarshalText([]byte("abc")))
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		var o encoding.TextMarshaler = Some(42)
+
+		text, err := o.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "42", string(text))
+	})
+}
+
+func binaryRoundTrip[T any](t *testing.T, o *Option[T]) *Option[T] {
+	t.Helper()
+
+	data, err := o.MarshalBinary()
+	require.NoError(t, err)
+
+	var out Option[T]
+	require.NoError(t, out.UnmarshalBinary(data))
+
+	return &out
+}
+
+func TestOption_MarshalBinary(t *testing.T) {
+	assert.Equal(t, None[string](), binaryRoundTrip(t, None[string]()))
+	assert.Equal(t, Some(42), binaryRoundTrip(t, Some(42)))
+	assert.Equal(t, Some(point{X: 1, Y: 2}), binaryRoundTrip(t, Some(point{X: 1, Y: 2})))
+	assert.Equal(t, Some(Some("str")), binaryRoundTrip(t, Some(Some("str"))))
+	assert.Equal(t, Some(None[int]()), binaryRoundTrip(t, Some(None[int]())))
+
+	t.Run("BinaryMarshaler", func(t *testing.T) {
+		data, err := Some(upper{s: "value"}).MarshalBinary()
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte{1}, "VALUE"...), data)
+
+		assert.Equal(t, Some(upper{s: "value"}), binaryRoundTrip(t, Some(upper{s: "value"})))
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		var o Option[int]
+
+		require.Error(t, o.UnmarshalBinary(nil))
+		require.Error(t, o.UnmarshalBinary([]byte{2}))
+		require.Error(t, o.UnmarshalBinary([]byte{1, 0xff}))
+	})
+}
+
+type cacheEntry struct {
+	Name    Option[string]
+	Age     Option[int]
+	Address *Option[upper]
+}
+
+func TestOption_Gob(t *testing.T) {
+	entries := []cacheEntry{
+		{Name: *Some("John"), Age: *None[int](), Address: Some(upper{s: "street"})},
+		{Name: *None[string](), Age: *Some(0), Address: None[upper]()},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(entries))
+
+	var out []cacheEntry
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+
+	assert.Equal(t, entries, out)
+}
@@ -0,0 +1,63 @@
+package st
+
+import (
+	"flag"
+	"reflect"
+)
+
+// OptionFlag defines a flag with the given name and usage on the FlagSet, and returns the Option storing its value.
+// The Option stays None if the flag isn't passed, and is set to Some of the parsed value otherwise.
+//
+// Strings, bools, integers, floats, time.Duration and types implementing encoding.TextUnmarshaler are supported. Like
+// the flags of the flag package, bool flags can be passed without a value, e.g. `-verbose`.
+func OptionFlag[T any](fs *flag.FlagSet, name string, usage string) *Option[T] {
+	o := None[T]()
+	OptionFlagVar(fs, o, name, usage)
+
+	return o
+}
+
+// OptionFlagVar defines a flag with the given name and usage on the FlagSet, storing its value in the Option. The
+// Option is left untouched if the flag isn't passed, see OptionFlag.
+func OptionFlagVar[T any](fs *flag.FlagSet, o *Option[T], name string, usage string) {
+	fs.Var(&optionFlag[T]{o: o}, name, usage)
+}
+
+// optionFlag adapts an Option to flag.Value.
+type optionFlag[T any] struct {
+	o *Option[T]
+}
+
+// String returns the text of the value, or an empty string for a None, which the flag package shows as no default.
+func (f *optionFlag[T]) String() string {
+	if f.o == nil || !f.o.ok {
+		return ""
+	}
+
+	text, err := marshalText(f.o.val)
+	if err != nil {
+		return f.o.String()
+	}
+
+	return string(text)
+}
+
+// Set parses the value of the flag. The flag package reports the error, if any, along with the name of the flag.
+func (f *optionFlag[T]) Set(s string) error {
+	var v T
+
+	err := unmarshalText([]byte(s), &v)
+	if err != nil {
+		return err
+	}
+
+	f.o.ok = true
+	f.o.val = v
+
+	return nil
+}
+
+// IsBoolFlag tells the flag package that bool flags can be passed without a value.
+func (f *optionFlag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
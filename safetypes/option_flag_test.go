@@ -0,0 +1,119 @@
+package st
+
+import (
+	"bytes"
+	"flag"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cliFlags struct {
+	fs      *flag.FlagSet
+	name    *Option[string]
+	count   *Option[int]
+	ratio   *Option[float64]
+	verbose *Option[bool]
+	timeout *Option[time.Duration]
+	addr    *Option[netip.Addr]
+	output  *bytes.Buffer
+}
+
+func newCLIFlags() *cliFlags {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	var out bytes.Buffer
+	fs.SetOutput(&out)
+
+	return &cliFlags{
+		fs:      fs,
+		name:    OptionFlag[string](fs, "name", "the name"),
+		count:   OptionFlag[int](fs, "count", "the count"),
+		ratio:   OptionFlag[float64](fs, "ratio", "the ratio"),
+		verbose: OptionFlag[bool](fs, "verbose", "verbose output"),
+		timeout: OptionFlag[time.Duration](fs, "timeout", "the timeout"),
+		addr:    OptionFlag[netip.Addr](fs, "addr", "the address"),
+		output:  &out,
+	}
+}
+
+func TestOptionFlag_UnsetFlagsStayNone(t *testing.T) {
+	f := newCLIFlags()
+
+	require.NoError(t, f.fs.Parse([]string{"-name", "john"}))
+
+	assert.Equal(t, Some("john"), f.name)
+	assert.Equal(t, None[int](), f.count)
+	assert.Equal(t, None[float64](), f.ratio)
+	assert.Equal(t, None[bool](), f.verbose)
+	assert.Equal(t, None[time.Duration](), f.timeout)
+	assert.Equal(t, None[netip.Addr](), f.addr)
+}
+
+func TestOptionFlag_ParsesSetFlags(t *testing.T) {
+	f := newCLIFlags()
+
+	args := []string{
+		"-name=",
+		"-count", "-3",
+		"-ratio=0.5",
+		"-verbose",
+		"-timeout", "1m30s",
+		"-addr", "10.0.0.1",
+		"rest",
+	}
+	require.NoError(t, f.fs.Parse(args))
+
+	assert.Equal(t, Some(""), f.name)
+	assert.Equal(t, Some(-3), f.count)
+	assert.Equal(t, Some(0.5), f.ratio)
+	assert.Equal(t, Some(true), f.verbose)
+	assert.Equal(t, Some(90*time.Second), f.timeout)
+	assert.Equal(t, Some(netip.MustParseAddr("10.0.0.1")), f.addr)
+	assert.Equal(t, []string{"rest"}, f.fs.Args())
+
+	t.Run("explicit false", func(t *testing.T) {
+		f := newCLIFlags()
+
+		require.NoError(t, f.fs.Parse([]string{"-verbose=false"}))
+		assert.Equal(t, Some(false), f.verbose)
+	})
+}
+
+func TestOptionFlag_ReportsParseErrors(t *testing.T) {
+	for name, args := range map[string][]string{
+		"count":   {"-count", "abc"},
+		"ratio":   {"-ratio", "abc"},
+		"verbose": {"-verbose=maybe"},
+		"timeout": {"-timeout", "10"},
+		"addr":    {"-addr", "not-an-ip"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := newCLIFlags()
+
+			err := f.fs.Parse(args)
+			require.ErrorContains(t, err, "invalid")
+			require.ErrorContains(t, err, "-"+name+":")
+			assert.Contains(t, f.output.String(), "Usage of test:")
+		})
+	}
+}
+
+func TestOptionFlag_Defaults(t *testing.T) {
+	f := newCLIFlags()
+	f.fs.PrintDefaults()
+
+	assert.Contains(t, f.output.String(), "-count value\n    \tthe count\n")
+	assert.NotContains(t, f.output.String(), "default")
+
+	existing := Some(5)
+	OptionFlagVar(f.fs, existing, "retries", "the retries")
+
+	require.NoError(t, f.fs.Parse(nil))
+	assert.Equal(t, Some(5), existing)
+	assert.Equal(t, "5", f.fs.Lookup("retries").Value.String())
+	assert.Empty(t, f.fs.Lookup("count").Value.String())
+}
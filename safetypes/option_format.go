@@ -0,0 +1,57 @@
+package st
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+)
+
+// Format implements fmt.Formatter. A Some value is printed as `Some(...)`, with the verb and flags passed through to
+// the contained value, e.g. `%+v` or `%q`. `%#v` prints the same as GoString.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('#'):
+		_, _ = io.WriteString(s, o.GoString())
+	case o.ok:
+		formatVariant(s, verb, "Some", o.val)
+	default:
+		_, _ = io.WriteString(s, "None")
+	}
+}
+
+// GoString implements fmt.GoStringer, printing the Option as the Go expression creating it, e.g. `st.Some[int](42)`.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) GoString() string {
+	if o.ok {
+		return fmt.Sprintf("st.Some[%s](%#v)", typeName[T](), o.val)
+	}
+
+	return fmt.Sprintf("st.None[%s]()", typeName[T]())
+}
+
+// LogValue implements slog.LogValuer. A Some value is logged as a group with its contained value under `some`, which
+// is itself resolved if it implements slog.LogValuer. A None is logged as nil, like its JSON encoding.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) LogValue() slog.Value {
+	if !o.ok {
+		return slog.AnyValue(nil)
+	}
+
+	return slog.GroupValue(slog.Any("some", o.val))
+}
+
+// formatVariant prints `name(val)`, with the verb and flags of the state applied to `val`.
+func formatVariant(s fmt.State, verb rune, name string, val any) {
+	_, _ = io.WriteString(s, name+"(")
+	_, _ = fmt.Fprintf(s, fmt.FormatString(s, verb), val)
+	_, _ = io.WriteString(s, ")")
+}
+
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
@@ -0,0 +1,99 @@
+package st
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type point struct {
+	X int
+	Y int
+}
+
+// logJSON logs the value under the `v` key with a JSON handler, and returns the decoded value.
+func logJSON(t *testing.T, v any) any {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "v", v)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	return entry["v"]
+}
+
+func TestOption_Format(t *testing.T) {
+	p := point{X: 1, Y: 2}
+
+	tests := []struct {
+		format   string
+		opt      any
+		expected string
+	}{
+		{"%v", Some(42), "Some(42)"},
+		{"%v", *Some(42), "Some(42)"},
+		{"%v", None[int](), "None"},
+		{"%s", Some("str"), "Some(str)"},
+		{"%+v", Some(p), "Some({X:1 Y:2})"},
+		{"%q", Some("str"), `Some("str")`},
+		{"%q", None[string](), "None"},
+		{"%5d", Some(42), "Some(   42)"},
+		{"%#v", Some(p), "st.Some[st.point](st.point{X:1, Y:2})"},
+		{"%#v", None[string](), "st.None[string]()"},
+		{"%v", Some(Some(1)), "Some(Some(1))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+" "+tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fmt.Sprintf(tt.format, tt.opt))
+		})
+	}
+}
+
+func TestOption_GoString(t *testing.T) {
+	assert.Equal(t, `st.Some[string]("str")`, Some("str").GoString())
+	assert.Equal(t, "st.None[[]int]()", None[[]int]().GoString())
+}
+
+func TestOption_LogValue(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		expected := map[string]any{"some": map[string]any{"X": 1.0, "Y": 2.0}}
+		assert.Equal(t, expected, logJSON(t, Some(point{X: 1, Y: 2})))
+	})
+
+	t.Run("None", func(t *testing.T) {
+		assert.Nil(t, logJSON(t, None[int]()))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		expected := map[string]any{"some": map[string]any{"some": "str"}}
+		assert.Equal(t, expected, logJSON(t, Some(Some("str"))))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			AddSource: false,
+			Level:     nil,
+			ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey {
+					return slog.Attr{} //nolint:exhaustruct // An empty attribute is dropped
+				}
+
+				return a
+			},
+		}))
+		logger.Info("msg", "user", Some("john"))
+
+		assert.Equal(t, "level=INFO msg=msg user.some=john\n", buf.String())
+	})
+}
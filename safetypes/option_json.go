@@ -0,0 +1,62 @@
+package st
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+//nolint:gochecknoglobals // Read-only, but byte slices can't be constants
+var jsonNull = []byte("null")
+
+// IsZero returns true if the Option is a None value.
+//
+// This allows fields of type Option[T] or *Option[T] tagged with `json:",omitzero"` to be omitted when None.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) IsZero() bool {
+	return !o.ok
+}
+
+// MarshalJSON implements json.Marshaler. A Some value is encoded as its contained value, a None is encoded as null.
+//
+// Note that Some(None) of an Option[Option[T]] is encoded as null too, so it is decoded back as None: JSON can't tell
+// the two apart. Use a Patch to tell a null apart from a missing value.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return jsonNull, nil
+	}
+
+	// Marshal through a pointer so nested Options are encoded with their own MarshalJSON.
+	return json.Marshal(&o.val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A null is decoded as None, any other value is decoded into T and wrapped
+// in Some.
+//
+// Note that encoding/json sets *Option[T] fields to nil when it encounters a null, and leaves missing fields untouched.
+// Both are read as None, since a nil Option is a None, but Insert, Replace and the GetOrInsert methods panic on them.
+// Use Option[T] fields to have both decoded as an actual None.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		var v T
+
+		o.ok = false
+		o.val = v
+
+		return nil
+	}
+
+	var v T
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	o.ok = true
+	o.val = v
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package st
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOption_MarshalJSON(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		val := fake.Int()
+
+		data, err := json.Marshal(Some(val))
+		require.NoError(t, err)
+
+		expected, err := json.Marshal(val)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, string(expected), string(data))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		data, err := json.Marshal(None[int]())
+		require.NoError(t, err)
+
+		assert.JSONEq(t, "null", string(data))
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+		type DTO struct {
+			Name    *Option[string] `json:"name"`
+			Age     Option[int]     `json:"age"`
+			Missing *Option[int]    `json:"missing"`
+		}
+
+		name := fake.RandomStringWithLength(8)
+		age := fake.IntBetween(1, 100)
+		dto := DTO{Name: Some(name), Age: *Some(age), Missing: None[int]()}
+
+		// Marshalling by value means the fields aren't addressable
+		data, err := json.Marshal(dto)
+		require.NoError(t, err)
+
+		expected, err := json.Marshal(map[string]any{"name": name, "age": age, "missing": nil})
+		require.NoError(t, err)
+
+		assert.JSONEq(t, string(expected), string(data))
+	})
+
+	t.Run("omitzero", func(t *testing.T) {
+		type DTO struct {
+			A *Option[int]   `json:"a,omitzero"`
+			B Option[string] `json:"b,omitzero"`
+			C *Option[int]   `json:"c,omitzero"`
+			D *Option[int]   `json:"d,omitzero"`
+		}
+
+		dto := DTO{A: None[int](), B: *None[string](), C: nil, D: Some(0)}
+
+		data, err := json.Marshal(dto)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"d": 0}`, string(data))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		val := fake.Int()
+
+		data, err := json.Marshal(Some(*Some(val)))
+		require.NoError(t, err)
+
+		expected, err := json.Marshal(val)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, string(expected), string(data))
+
+		data, err = json.Marshal(Some(*None[int]()))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, "null", string(data))
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		data, err := json.Marshal(Some([]int{1, 2, 3}))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, "[1, 2, 3]", string(data))
+	})
+}
+
+func TestOption_UnmarshalJSON(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		val := fake.Int()
+
+		data, err := json.Marshal(val)
+		require.NoError(t, err)
+
+		var opt Option[int]
+		require.NoError(t, json.Unmarshal(data, &opt))
+
+		assert.Equal(t, Some(val), &opt)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		opt := Some(fake.Int())
+		require.NoError(t, json.Unmarshal([]byte(" null "), opt))
+
+		assert.Equal(t, None[int](), opt)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		opt := None[int]()
+		require.Error(t, json.Unmarshal([]byte(`"not an int"`), opt))
+
+		assert.Equal(t, None[int](), opt)
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+		type DTO struct {
+			Name    Option[string] `json:"name"`
+			Age     Option[int]    `json:"age"`
+			Missing Option[int]    `json:"missing"`
+		}
+
+		var dto DTO
+		require.NoError(t, json.Unmarshal([]byte(`{"name": "John", "age": null}`), &dto))
+
+		assert.Equal(t, Some("John"), &dto.Name)
+		assert.Equal(t, None[int](), &dto.Age)
+		assert.Equal(t, None[int](), &dto.Missing)
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		type DTO struct {
+			X *Option[int] `json:"x"`
+		}
+
+		for _, data := range []string{`{}`, `{"x": null}`} {
+			var dto DTO
+			require.NoError(t, json.Unmarshal([]byte(data), &dto))
+
+			assert.True(t, dto.X.IsNone(), data)
+			assert.False(t, dto.X.IsSome(), data)
+			assert.Equal(t, 42, dto.X.UnwrapOr(42), data)
+			assert.Zero(t, dto.X.UnwrapOrDefault(), data)
+			assert.Equal(t, Some(1), dto.X.Or(Some(1)), data)
+			assert.Equal(t, "None", dto.X.String(), data)
+		}
+
+		var dto DTO
+		require.NoError(t, json.Unmarshal([]byte(`{"x": 42}`), &dto))
+
+		assert.Equal(t, Some(42), dto.X)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		var opt Option[Option[int]]
+		require.NoError(t, json.Unmarshal([]byte(`42`), &opt))
+
+		assert.Equal(t, Some(*Some(42)), &opt)
+
+		require.NoError(t, json.Unmarshal([]byte(`null`), &opt))
+
+		assert.Equal(t, None[Option[int]](), &opt)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		var opt Option[[]string]
+		require.NoError(t, json.Unmarshal([]byte(`["a", "b"]`), &opt))
+
+		assert.Equal(t, Some([]string{"a", "b"}), &opt)
+	})
+}
+
+func TestOption_JSONRoundTrip(t *testing.T) {
+	for name, opt := range map[string]*Option[string]{
+		"some": Some(fake.RandomStringWithLength(8)),
+		"none": None[string](),
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(opt)
+			require.NoError(t, err)
+
+			var res Option[string]
+			require.NoError(t, json.Unmarshal(data, &res))
+
+			assert.Equal(t, opt, &res)
+		})
+	}
+
+	t.Run("nested none", func(t *testing.T) {
+		data, err := json.Marshal(Some(*None[int]()))
+		require.NoError(t, err)
+
+		var res Option[Option[int]]
+		require.NoError(t, json.Unmarshal(data, &res))
+
+		assert.Equal(t, None[Option[int]](), &res)
+	})
+}
@@ -0,0 +1,33 @@
+package st
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements sql.Scanner. A NULL column is scanned as None, any other value is converted to T and wrapped in
+// Some.
+//
+// Conversions follow the rules of sql.Rows.Scan: driver values (int64, float64, bool, []byte, string and time.Time)
+// are converted to T when possible, and T is used directly if it implements sql.Scanner.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+
+	err := n.Scan(src)
+	if err != nil {
+		return err
+	}
+
+	o.ok = n.Valid
+	o.val = n.V
+
+	return nil
+}
+
+// Value implements driver.Valuer. A None is stored as NULL, a Some is stored as its contained value converted to a
+// driver value.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Options are handled as well
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.val, Valid: o.ok}.Value()
+}
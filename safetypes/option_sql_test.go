@@ -0,0 +1,256 @@
+package st
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver is an in-process database/sql driver. Every query returns the rows registered under the DSN, and every
+// statement execution records its arguments.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows map[string][][]driver.Value
+	args map[string][]driver.Value
+}
+
+var fakeDB = &fakeDriver{
+	mu:   sync.Mutex{},
+	rows: make(map[string][][]driver.Value),
+	args: make(map[string][]driver.Value),
+}
+
+func init() { //nolint:gochecknoinits // Drivers can only be registered once
+	sql.Register("grust-fake", fakeDB)
+}
+
+func openFakeDB(t *testing.T, rows ...[]driver.Value) *sql.DB {
+	t.Helper()
+
+	dsn := t.Name()
+
+	fakeDB.mu.Lock()
+	fakeDB.rows[dsn] = rows
+	fakeDB.mu.Unlock()
+
+	db, err := sql.Open("grust-fake", dsn)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+
+		fakeDB.mu.Lock()
+		delete(fakeDB.rows, dsn)
+		delete(fakeDB.args, dsn)
+		fakeDB.mu.Unlock()
+	})
+
+	return db
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	return &fakeConn{dsn: dsn}, nil
+}
+
+type fakeConn struct {
+	dsn string
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return &fakeStmt{dsn: c.dsn}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	dsn string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fakeDB.mu.Lock()
+	defer fakeDB.mu.Unlock()
+
+	fakeDB.args[s.dsn] = args
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	fakeDB.mu.Lock()
+	defer fakeDB.mu.Unlock()
+
+	values := fakeDB.rows[s.dsn]
+
+	var columns []string
+	if len(values) > 0 {
+		columns = make([]string, len(values[0]))
+	}
+
+	return &fakeRows{columns: columns, values: values}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+
+	return nil
+}
+
+func TestOption_Scan(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("some", func(t *testing.T) {
+		db := openFakeDB(t, []driver.Value{
+			int64(42), float64(1.5), true, []byte("bytes"), "string",
+			now, int64(7), "12", []byte("3.5"),
+		})
+
+		var (
+			i64   Option[int64]
+			f64   Option[float64]
+			b     Option[bool]
+			bs    Option[[]byte]
+			s     Option[string]
+			tm    Option[time.Time]
+			i     Option[int]
+			fromS Option[int]
+			fromB Option[float32]
+		)
+
+		err := db.QueryRowContext(context.Background(), "SELECT").
+			Scan(&i64, &f64, &b, &bs, &s, &tm, &i, &fromS, &fromB)
+		require.NoError(t, err)
+
+		assert.Equal(t, Some[int64](42), &i64)
+		assert.Equal(t, Some(1.5), &f64)
+		assert.Equal(t, Some(true), &b)
+		assert.Equal(t, Some([]byte("bytes")), &bs)
+		assert.Equal(t, Some("string"), &s)
+		assert.Equal(t, Some(now), &tm)
+		assert.Equal(t, Some(7), &i)
+		assert.Equal(t, Some(12), &fromS)
+		assert.Equal(t, Some[float32](3.5), &fromB)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		db := openFakeDB(t, []driver.Value{nil, nil, nil})
+
+		i := Some(fake.Int())
+		s := Some(fake.RandomStringWithLength(8))
+		tm := Some(now)
+
+		err := db.QueryRowContext(context.Background(), "SELECT").Scan(i, s, tm)
+		require.NoError(t, err)
+
+		assert.Equal(t, None[int](), i)
+		assert.Equal(t, None[string](), s)
+		assert.Equal(t, None[time.Time](), tm)
+	})
+
+	t.Run("scanner", func(t *testing.T) {
+		db := openFakeDB(t, []driver.Value{"value"}, []driver.Value{nil})
+
+		rows, err := db.QueryContext(context.Background(), "SELECT")
+		require.NoError(t, err)
+
+		defer rows.Close()
+
+		var res []*Option[sql.NullString]
+
+		for rows.Next() {
+			var opt Option[sql.NullString]
+			require.NoError(t, rows.Scan(&opt))
+
+			res = append(res, &opt)
+		}
+
+		require.NoError(t, rows.Err())
+
+		expected := []*Option[sql.NullString]{
+			Some(sql.NullString{String: "value", Valid: true}),
+			None[sql.NullString](),
+		}
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("invalid conversion", func(t *testing.T) {
+		db := openFakeDB(t, []driver.Value{"not a number"})
+
+		opt := Some(fake.Int())
+
+		err := db.QueryRowContext(context.Background(), "SELECT").Scan(opt)
+		require.Error(t, err)
+
+		assert.True(t, opt.IsSome(), "option should have been left untouched")
+	})
+}
+
+func TestOption_Value(t *testing.T) {
+	now := time.Now()
+
+	t.Run("direct", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			opt      driver.Valuer
+			expected driver.Value
+		}{
+			{"some int", Some(42), int64(42)},
+			{"some uint8", Some[uint8](42), int64(42)},
+			{"some float32", Some[float32](1.5), float64(1.5)},
+			{"some string", Some("value"), "value"},
+			{"some bool", Some(true), true},
+			{"some bytes", Some([]byte("bytes")), []byte("bytes")},
+			{"some time", Some(now), now},
+			{"some valuer", Some(sql.NullInt64{Int64: 42, Valid: true}), int64(42)},
+			{"none", None[int](), nil},
+			{"value", *Some("value"), "value"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				v, err := tc.opt.Value()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, v)
+			})
+		}
+	})
+
+	t.Run("exec", func(t *testing.T) {
+		db := openFakeDB(t)
+
+		_, err := db.ExecContext(
+			context.Background(),
+			"INSERT",
+			Some(42),
+			None[string](),
+			*Some("value"),
+		)
+		require.NoError(t, err)
+
+		fakeDB.mu.Lock()
+		defer fakeDB.mu.Unlock()
+
+		assert.Equal(t, []driver.Value{int64(42), nil, "value"}, fakeDB.args[t.Name()])
+	})
+}
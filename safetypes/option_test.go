@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/RogueConsultingDev/grust/it"
 )
 
 func TestFrom_ReturnsNewOptionFromArgs(t *testing.T) {
@@ -193,6 +195,78 @@ func TestAndThen_ReturnsMappedOptionOrNone(t *testing.T) {
 	})
 }
 
+func TestZipOption_ReturnsATupleIfBothAreSome(t *testing.T) {
+	a := fake.Int()
+	b := fake.RandomStringWithLength(8)
+
+	assert.Equal(t, Some(it.Tuple[int, string]{A: a, B: b}), ZipOption(Some(a), Some(b)))
+	assert.Equal(t, None[it.Tuple[int, string]](), ZipOption(Some(a), None[string]()))
+	assert.Equal(t, None[it.Tuple[int, string]](), ZipOption(None[int](), Some(b)))
+	assert.Equal(t, None[it.Tuple[int, string]](), ZipOption(None[int](), None[string]()))
+}
+
+func TestUnzipOption_ReturnsATupleOfOptions(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		a := fake.Int()
+		b := fake.RandomStringWithLength(8)
+
+		resA, resB := UnzipOption(Some(it.Tuple[int, string]{A: a, B: b}))
+
+		assert.Equal(t, Some(a), resA)
+		assert.Equal(t, Some(b), resB)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		resA, resB := UnzipOption(None[it.Tuple[int, string]]())
+
+		assert.Equal(t, None[int](), resA)
+		assert.Equal(t, None[string](), resB)
+	})
+}
+
+func TestFlattenOption_RemovesOneLevelOfNesting(t *testing.T) {
+	t.Run("some some", func(t *testing.T) {
+		inner := Some(fake.Int())
+
+		assert.Same(t, inner, FlattenOption(Some(inner)))
+	})
+
+	t.Run("some none", func(t *testing.T) {
+		inner := None[int]()
+
+		assert.Same(t, inner, FlattenOption(Some(inner)))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		assert.Equal(t, None[int](), FlattenOption(None[*Option[int]]()))
+	})
+}
+
+func TestOptionContains_ReturnsTrueIfSomeAndEqual(t *testing.T) {
+	val := fake.Int()
+
+	assert.True(t, OptionContains(Some(val), val))
+	assert.False(t, OptionContains(Some(val), val+1))
+	assert.False(t, OptionContains(None[int](), val))
+	assert.False(t, OptionContains(None[int](), 0))
+}
+
+func TestCopiedOption_DereferencesTheValue(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		val := fake.Int()
+		ptr := &val
+
+		res := CopiedOption(Some(ptr))
+		*ptr++
+
+		assert.Equal(t, Some(val-1), res)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		assert.Equal(t, None[int](), CopiedOption(None[*int]()))
+	})
+}
+
 func TestOption_IsNone(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		val := fake.Int()
@@ -808,6 +882,29 @@ func TestOption_Take(t *testing.T) {
 	})
 }
 
+func TestOption_Replace(t *testing.T) {
+	t.Run("Some", func(t *testing.T) {
+		val := fake.Int()
+		o := Some(val)
+
+		newVal := fake.Int()
+		res := o.Replace(newVal)
+
+		assert.Equal(t, Some(val), res)
+		assert.Equal(t, Some(newVal), o)
+	})
+
+	t.Run("None", func(t *testing.T) {
+		o := None[int]()
+
+		newVal := fake.Int()
+		res := o.Replace(newVal)
+
+		assert.Equal(t, None[int](), res)
+		assert.Equal(t, Some(newVal), o)
+	})
+}
+
 func TestOption_TakeIf(t *testing.T) {
 	t.Run("Some", func(t *testing.T) {
 		val := fake.Int()
@@ -889,3 +986,58 @@ func TestOption_String(t *testing.T) {
 		assert.Equal(t, expected, o.String())
 	})
 }
+
+func TestOption_NilReceiver(t *testing.T) {
+	var o *Option[int]
+
+	err := errors.New(fake.RandomStringWithLength(8))
+	unexpected := func(int) { assert.Fail(t, "function should not have been called") }
+
+	t.Run("IsNone", func(t *testing.T) { assert.True(t, o.IsNone()) })
+	t.Run("IsNoneOr", func(t *testing.T) {
+		assert.True(t, o.IsNoneOr(func(int) bool { return false }))
+	})
+	t.Run("IsSome", func(t *testing.T) { assert.False(t, o.IsSome()) })
+	t.Run("IsSomeAnd", func(t *testing.T) {
+		assert.False(t, o.IsSomeAnd(func(int) bool { return true }))
+	})
+	t.Run("Expect", func(t *testing.T) {
+		assert.Panics(t, func() { o.Expect("msg") })
+	})
+	t.Run("Unwrap", func(t *testing.T) { assert.Panics(t, func() { o.Unwrap() }) })
+	t.Run("UnwrapOr", func(t *testing.T) { assert.Equal(t, 1, o.UnwrapOr(1)) })
+	t.Run("UnwrapOrElse", func(t *testing.T) {
+		assert.Equal(t, 1, o.UnwrapOrElse(func() int { return 1 }))
+	})
+	t.Run("UnwrapOrDefault", func(t *testing.T) { assert.Zero(t, o.UnwrapOrDefault()) })
+	t.Run("AsOkOr", func(t *testing.T) { assert.Equal(t, Err[int](err), o.AsOkOr(err)) })
+	t.Run("AsOkOrElse", func(t *testing.T) {
+		assert.Equal(t, Err[int](err), o.AsOkOrElse(func() error { return err }))
+	})
+	t.Run("Inspect", func(t *testing.T) { assert.True(t, o.Inspect(unexpected).IsNone()) })
+	t.Run("Filter", func(t *testing.T) {
+		assert.Equal(t, None[int](), o.Filter(func(int) bool { return true }))
+	})
+	t.Run("Or", func(t *testing.T) { assert.Equal(t, Some(1), o.Or(Some(1))) })
+	t.Run("OrElse", func(t *testing.T) {
+		assert.Equal(t, Some(1), o.OrElse(func() *Option[int] { return Some(1) }))
+	})
+	t.Run("Xor", func(t *testing.T) {
+		assert.Equal(t, Some(1), o.Xor(Some(1)))
+		assert.Equal(t, None[int](), o.Xor(None[int]()))
+		assert.Equal(t, None[int](), o.Xor(nil))
+	})
+	t.Run("Take", func(t *testing.T) { assert.True(t, o.Take().IsNone()) })
+	t.Run("TakeIf", func(t *testing.T) {
+		assert.Equal(t, None[int](), o.TakeIf(func(int) bool { return true }))
+	})
+	t.Run("String", func(t *testing.T) { assert.Equal(t, "None", o.String()) })
+
+	t.Run("mutating methods panic", func(t *testing.T) {
+		assert.Panics(t, func() { o.Insert(1) })
+		assert.Panics(t, func() { o.Replace(1) })
+		assert.Panics(t, func() { o.GetOrInsert(1) })
+		assert.Panics(t, func() { o.GetOrInsertDefault() })
+		assert.Panics(t, func() { o.GetOrInsertWith(func() int { return 1 }) })
+	})
+}
@@ -0,0 +1,325 @@
+package st
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type patchState uint8
+
+const (
+	patchAbsent patchState = iota
+	patchNull
+	patchValue
+)
+
+// PatchAbsent creates a Patch leaving the field untouched.
+func PatchAbsent[T any]() *Patch[T] {
+	var v T
+
+	return &Patch[T]{
+		state: patchAbsent,
+		val:   v,
+	}
+}
+
+// PatchNull creates a Patch clearing the field.
+func PatchNull[T any]() *Patch[T] {
+	var v T
+
+	return &Patch[T]{
+		state: patchNull,
+		val:   v,
+	}
+}
+
+// PatchValue creates a Patch setting the field to the value.
+func PatchValue[T any](val T) *Patch[T] {
+	return &Patch[T]{
+		state: patchValue,
+		val:   val,
+	}
+}
+
+// PatchOf creates a Patch from its Option representation: None is Absent, Some(None) is Null and Some(Some(v)) is
+// Value(v).
+func PatchOf[T any](o *Option[*Option[T]]) *Patch[T] {
+	switch {
+	case o.IsNone():
+		return PatchAbsent[T]()
+	case o.Unwrap().IsNone():
+		return PatchNull[T]()
+	default:
+		return PatchValue(o.Unwrap().Unwrap())
+	}
+}
+
+// Patch is a type that represents the change of a field in a partial update, like a JSON Merge Patch (RFC 7396): the
+// field is either Absent and left untouched, Null and cleared, or set to a Value.
+//
+// Unlike an Option, a Patch decoded from JSON tells a missing field (Absent) apart from an explicit null (Null). Like
+// Options, Patch fields must not be pointers for that to work, since the zero value of a Patch is Absent.
+type Patch[T any] struct {
+	state patchState
+	val   T
+}
+
+// IsAbsent returns true if the Patch leaves the field untouched.
+func (p *Patch[T]) IsAbsent() bool {
+	return p.state == patchAbsent
+}
+
+// IsNull returns true if the Patch clears the field.
+func (p *Patch[T]) IsNull() bool {
+	return p.state == patchNull
+}
+
+// IsValue returns true if the Patch sets the field to a value.
+func (p *Patch[T]) IsValue() bool {
+	return p.state == patchValue
+}
+
+// AsOption converts the Patch to its Option representation: None if Absent, Some(None) if Null and Some(Some(v)) if
+// Value(v).
+func (p *Patch[T]) AsOption() *Option[*Option[T]] {
+	switch p.state {
+	case patchNull:
+		return Some(None[T]())
+	case patchValue:
+		return Some(Some(p.val))
+	default:
+		return None[*Option[T]]()
+	}
+}
+
+func (p *Patch[T]) String() string {
+	switch p.state {
+	case patchNull:
+		return "Null"
+	case patchValue:
+		return fmt.Sprintf("Value(%v)", p.val)
+	default:
+		return "Absent"
+	}
+}
+
+// IsZero returns true if the Patch is Absent, so that Absent fields tagged with `json:",omitzero"` are omitted.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Patches are handled as well
+func (p Patch[T]) IsZero() bool {
+	return p.state == patchAbsent
+}
+
+// MarshalJSON implements json.Marshaler. A Value is encoded as its value, Absent and Null are encoded as null.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Patches are handled as well
+func (p Patch[T]) MarshalJSON() ([]byte, error) {
+	if p.state != patchValue {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(&p.val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A null is decoded as Null, any other value is decoded as a Value. Fields
+// missing from the JSON object are left Absent, since encoding/json doesn't call UnmarshalJSON for them.
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	var v T
+
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		p.state = patchNull
+		p.val = v
+
+		return nil
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	p.state = patchValue
+	p.val = v
+
+	return nil
+}
+
+//nolint:recvcheck // Value receiver so that non-addressable Patches are handled as well
+func (p Patch[T]) patch() (patchState, reflect.Value) {
+	return p.state, reflect.ValueOf(&p.val).Elem()
+}
+
+// patchInner makes the Option a Some, keeping its value if it already was one, and returns its settable value.
+func (o *Option[T]) patchInner() reflect.Value {
+	if !o.ok {
+		var v T
+
+		o.ok = true
+		o.val = v
+	}
+
+	return reflect.ValueOf(&o.val).Elem()
+}
+
+type patchField interface {
+	patch() (patchState, reflect.Value)
+}
+
+type patchTarget interface {
+	patchInner() reflect.Value
+}
+
+var errInvalidPatch = errors.New("invalid patch")
+
+// ApplyPatch merges a patch onto the struct pointed to by `dst`, following the semantics of JSON Merge Patch
+// (RFC 7396). The patch is a struct, or a pointer to one, whose fields are all Patches. Each Patch is applied to the
+// field of `dst` with the same name:
+//   - Absent leaves the field untouched.
+//   - Null sets the field to its zero value, which is None for Options.
+//   - Value sets the field to the value, wrapped in Some for Options. If the value is itself a struct of Patches, it
+//     is merged recursively onto the field instead, which is allocated if it is a nil pointer or a None.
+//
+// The patch is applied as a whole: if it returns an error, `dst` is left unchanged.
+func ApplyPatch(dst any, patch any) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Pointer || d.IsNil() || d.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf(
+			"%w: destination must be a non-nil pointer to a struct, got %T", errInvalidPatch, dst,
+		)
+	}
+
+	p := reflect.Indirect(reflect.ValueOf(patch))
+	if p.Kind() != reflect.Struct {
+		return fmt.Errorf(
+			"%w: patch must be a struct or a pointer to one, got %T", errInvalidPatch, patch,
+		)
+	}
+
+	// Apply the patch to a copy first, whose pointers are copied before being written through, so that a failure
+	// doesn't leave `dst` partly patched. Once it succeeded, applying it to `dst` itself can't fail.
+	dryRun := reflect.New(d.Elem().Type()).Elem()
+	dryRun.Set(d.Elem())
+
+	err := applyPatch(dryRun, p, true)
+	if err != nil {
+		return err
+	}
+
+	return applyPatch(d.Elem(), p, false)
+}
+
+// applyPatch merges the patch onto `dst`. If `copyOnWrite` is true, the values pointed to by `dst` are copied before
+// being modified, leaving the originals untouched.
+func applyPatch(dst reflect.Value, patch reflect.Value, copyOnWrite bool) error {
+	for i := range patch.NumField() {
+		sf := patch.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		if sf.Type.Kind() == reflect.Pointer {
+			return fmt.Errorf(
+				"%w: field %s of %s is a pointer, not a Patch",
+				errInvalidPatch, sf.Name, patch.Type(),
+			)
+		}
+
+		pf, ok := patch.Field(i).Interface().(patchField)
+		if !ok {
+			return fmt.Errorf(
+				"%w: field %s of %s is not a Patch", errInvalidPatch, sf.Name, patch.Type(),
+			)
+		}
+
+		df := dst.FieldByName(sf.Name)
+		if !df.IsValid() || !df.CanSet() {
+			return fmt.Errorf("%w: no field %s in %s", errInvalidPatch, sf.Name, dst.Type())
+		}
+
+		state, val := pf.patch()
+
+		switch state {
+		case patchAbsent:
+			// Left untouched
+		case patchNull:
+			df.SetZero()
+		case patchValue:
+			err := setPatchedField(df, val, copyOnWrite)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", sf.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func setPatchedField(dst reflect.Value, val reflect.Value, copyOnWrite bool) error {
+	if dst.Kind() == reflect.Pointer && dst.Type().Implements(reflect.TypeFor[patchTarget]()) {
+		allocPointer(dst, copyOnWrite)
+
+		dst = dst.Interface().(patchTarget).patchInner() //nolint:forcetypeassert // Checked above
+	} else if target, ok := dst.Addr().Interface().(patchTarget); ok {
+		dst = target.patchInner()
+	}
+
+	if isPatchStruct(val.Type()) {
+		if dst.Kind() == reflect.Pointer {
+			allocPointer(dst, copyOnWrite)
+
+			dst = dst.Elem()
+		}
+
+		if dst.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: can't merge %s onto %s", errInvalidPatch, val.Type(), dst.Type())
+		}
+
+		return applyPatch(dst, val, copyOnWrite)
+	}
+
+	switch {
+	case val.Type().AssignableTo(dst.Type()):
+		dst.Set(val)
+	case dst.Kind() == reflect.Pointer && val.Type().AssignableTo(dst.Type().Elem()):
+		ptr := reflect.New(dst.Type().Elem())
+		ptr.Elem().Set(val)
+		dst.Set(ptr)
+	default:
+		return fmt.Errorf("%w: can't assign %s to %s", errInvalidPatch, val.Type(), dst.Type())
+	}
+
+	return nil
+}
+
+// allocPointer makes the pointer `dst` point to a new zero value if it is nil, or to a copy of its value if
+// `copyOnWrite` is true, so that it can be written through.
+func allocPointer(dst reflect.Value, copyOnWrite bool) {
+	switch {
+	case dst.IsNil():
+		dst.Set(reflect.New(dst.Type().Elem()))
+	case copyOnWrite:
+		ptr := reflect.New(dst.Type().Elem())
+		ptr.Elem().Set(dst.Elem())
+		dst.Set(ptr)
+	}
+}
+
+// isPatchStruct returns true if the type is a struct with at least one Patch field.
+func isPatchStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	fieldType := reflect.TypeFor[patchField]()
+
+	for i := range t.NumField() {
+		if t.Field(i).Type.Implements(fieldType) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,267 @@
+package st
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type userPatch struct {
+	Name  Patch[string] `json:"name"`
+	Email Patch[string] `json:"email"`
+	Age   Patch[int]    `json:"age"`
+}
+
+func TestPatch_UnmarshalJSON(t *testing.T) {
+	var p userPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "John", "email": null}`), &p))
+
+	assert.Equal(t, PatchValue("John"), &p.Name)
+	assert.Equal(t, PatchNull[string](), &p.Email)
+	assert.Equal(t, PatchAbsent[int](), &p.Age)
+
+	assert.True(t, p.Name.IsValue())
+	assert.True(t, p.Email.IsNull())
+	assert.True(t, p.Age.IsAbsent())
+
+	require.Error(t, json.Unmarshal([]byte(`{"age": "str"}`), &p))
+}
+
+func TestPatch_MarshalJSON(t *testing.T) {
+	p := userPatch{Name: *PatchValue("John"), Email: *PatchNull[string](), Age: *PatchAbsent[int]()}
+
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "John", "email": null, "age": null}`, string(data))
+
+	omitted := struct {
+		Name  Patch[string] `json:"name,omitzero"`
+		Email Patch[string] `json:"email,omitzero"`
+	}{Name: *PatchValue("John"), Email: *PatchAbsent[string]()}
+
+	data, err = json.Marshal(omitted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "John"}`, string(data))
+}
+
+func TestPatch_AsOption(t *testing.T) {
+	assert.Equal(t, None[*Option[int]](), PatchAbsent[int]().AsOption())
+	assert.Equal(t, Some(None[int]()), PatchNull[int]().AsOption())
+	assert.Equal(t, Some(Some(42)), PatchValue(42).AsOption())
+
+	for _, p := range []*Patch[int]{PatchAbsent[int](), PatchNull[int](), PatchValue(42)} {
+		assert.Equal(t, p, PatchOf(p.AsOption()))
+	}
+}
+
+func TestPatch_String(t *testing.T) {
+	assert.Equal(t, "Absent", PatchAbsent[int]().String())
+	assert.Equal(t, "Null", PatchNull[int]().String())
+	assert.Equal(t, "Value(42)", PatchValue(42).String())
+}
+
+type user struct {
+	Name    string
+	Email   *string
+	Age     Option[int]
+	Address *Option[string]
+}
+
+func TestApplyPatch_MergesFields(t *testing.T) {
+	email := "john@example.com"
+	address := "1 main street"
+	u := user{Name: "John", Email: &email, Age: *Some(42), Address: Some(address)}
+
+	t.Run("values", func(t *testing.T) {
+		dst := user{Name: "", Email: nil, Age: *None[int](), Address: nil}
+		patch := struct {
+			Name    Patch[string]
+			Email   Patch[string]
+			Age     Patch[int]
+			Address Patch[string]
+		}{
+			Name:    *PatchValue("John"),
+			Email:   *PatchValue(email),
+			Age:     *PatchValue(42),
+			Address: *PatchValue(address),
+		}
+
+		require.NoError(t, ApplyPatch(&dst, patch))
+		assert.Equal(t, u, dst)
+	})
+
+	t.Run("nulls", func(t *testing.T) {
+		dst := u
+		patch := userPatch{
+			Name:  *PatchNull[string](),
+			Email: *PatchNull[string](),
+			Age:   *PatchNull[int](),
+		}
+
+		require.NoError(t, ApplyPatch(&dst, &patch))
+
+		expected := user{Name: "", Email: nil, Age: *None[int](), Address: Some(address)}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("absents", func(t *testing.T) {
+		dst := u
+
+		var patch userPatch
+
+		require.NoError(t, ApplyPatch(&dst, patch))
+		assert.Equal(t, u, dst)
+	})
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	email := "john@example.com"
+	newUser := func() user {
+		return user{Name: "John", Email: &email, Age: *None[int](), Address: None[string]()}
+	}
+
+	tests := map[string]struct {
+		patch    any
+		expected string
+	}{
+		"patch not a struct": {
+			patch:    42,
+			expected: "invalid patch: patch must be a struct or a pointer to one, got int",
+		},
+		"field not a Patch": {
+			patch:    struct{ Name string }{Name: "Jane"},
+			expected: "invalid patch: field Name of struct { Name string } is not a Patch",
+		},
+		"pointer field": {
+			patch: struct{ Name *Patch[string] }{Name: nil},
+			expected: "invalid patch: field Name of struct { Name *st.Patch[string] } is a " +
+				"pointer, not a Patch",
+		},
+		"missing field": {
+			patch:    struct{ Other Patch[int] }{Other: *PatchValue(1)},
+			expected: "invalid patch: no field Other in st.user",
+		},
+		"wrong type": {
+			patch:    struct{ Name Patch[int] }{Name: *PatchValue(1)},
+			expected: "field Name: invalid patch: can't assign int to string",
+		},
+		"wrong type after valid fields": {
+			patch: struct {
+				Name Patch[string]
+				Age  Patch[string]
+			}{Name: *PatchValue("Jane"), Age: *PatchValue("x")},
+			expected: "field Age: invalid patch: can't assign string to int",
+		},
+		"wrong type in pointer": {
+			patch: struct {
+				Email   Patch[string]
+				Address Patch[int]
+			}{Email: *PatchValue("jane@example.com"), Address: *PatchValue(1)},
+			expected: "field Address: invalid patch: can't assign int to string",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dst := newUser()
+
+			require.EqualError(t, ApplyPatch(&dst, tt.patch), tt.expected)
+			assert.Equal(t, newUser(), dst)
+		})
+	}
+
+	t.Run("dst not a pointer", func(t *testing.T) {
+		require.EqualError(
+			t,
+			ApplyPatch(newUser(), nil),
+			"invalid patch: destination must be a non-nil pointer to a struct, got st.user",
+		)
+	})
+}
+
+// Documents and patches able to represent the examples of RFC 7396, Appendix A.
+type (
+	mergeDoc struct {
+		A Option[any]     `json:"a,omitzero"`
+		B Option[string]  `json:"b,omitzero"`
+		E json.RawMessage `json:"e,omitempty"`
+	}
+	mergePatch struct {
+		A Patch[any]             `json:"a"`
+		B Patch[string]          `json:"b"`
+		E Patch[json.RawMessage] `json:"e"`
+	}
+
+	nestedDoc struct {
+		A Option[nestedDocA] `json:"a,omitzero"`
+	}
+	nestedDocA struct {
+		B  Option[string] `json:"b,omitzero"`
+		C  Option[string] `json:"c,omitzero"`
+		BB *nestedDocB    `json:"bb,omitempty"`
+	}
+	nestedDocB struct {
+		CCC Option[string] `json:"ccc,omitzero"`
+	}
+	nestedPatch struct {
+		A Patch[nestedPatchA] `json:"a"`
+	}
+	nestedPatchA struct {
+		B  Patch[string]       `json:"b"`
+		C  Patch[string]       `json:"c"`
+		BB Patch[nestedPatchB] `json:"bb"`
+	}
+	nestedPatchB struct {
+		CCC Patch[string] `json:"ccc"`
+	}
+)
+
+func mergeJSON[D any, P any](t *testing.T, target string, patch string) string {
+	t.Helper()
+
+	var doc D
+	require.NoError(t, json.Unmarshal([]byte(target), &doc))
+
+	var p P
+	require.NoError(t, json.Unmarshal([]byte(patch), &p))
+
+	require.NoError(t, ApplyPatch(&doc, p))
+
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestApplyPatch_RFC7396(t *testing.T) {
+	tests := []struct {
+		target   string
+		patch    string
+		expected string
+		merge    func(t *testing.T, target string, patch string) string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{"a":"b"}`, `{"a":null}`, `{}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`, mergeJSON[mergeDoc, mergePatch]},
+		{
+			`{"a":{"b":"c"}}`,
+			`{"a":{"b":"d","c":null}}`,
+			`{"a":{"b":"d"}}`,
+			mergeJSON[nestedDoc, nestedPatch],
+		},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`, mergeJSON[mergeDoc, mergePatch]},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`, mergeJSON[nestedDoc, nestedPatch]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target+" + "+tt.patch, func(t *testing.T) {
+			assert.JSONEq(t, tt.expected, tt.merge(t, tt.target, tt.patch))
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// ErrBorrowReleased is the error Ref.Value and RefMut.Value panic with when the borrow is already released.
+var ErrBorrowReleased = errors.New("use of a released RefCell borrow")
+
+// BorrowError is the error of a borrow conflicting with the active borrows of a RefCell. Borrow and BorrowMut panic
+// with it, TryBorrow and TryBorrowMut return it in an Err.
+type BorrowError struct {
+	// Mutable is true if the failed borrow was a mutable one.
+	Mutable bool
+	// Borrows is the number of active shared borrows, or 0 if the RefCell is mutably borrowed.
+	Borrows int
+	// Location is the `file:line` where the active mutable borrow was taken, or empty if there is none.
+	Location string
+}
+
+func (e *BorrowError) Error() string {
+	kind := "borrow RefCell"
+	if e.Mutable {
+		kind = "borrow RefCell as mutable"
+	}
+
+	if e.Location != "" {
+		return fmt.Sprintf("cannot %s: already mutably borrowed at %s", kind, e.Location)
+	}
+
+	return fmt.Sprintf("cannot %s: already borrowed %d time(s)", kind, e.Borrows)
+}
+
+// NewRefCell creates a RefCell containing the value.
+func NewRefCell[T any](val T) *RefCell[T] {
+	return &RefCell[T]{
+		mu:       sync.Mutex{},
+		val:      val,
+		borrows:  0,
+		mutAt:    "",
+		mutTaken: false,
+	}
+}
+
+// RefCell is a container whose borrows are checked at runtime, like Rust's `RefCell`: any number of shared borrows,
+// or a single mutable borrow, can be active at a time. It helps finding aliasing bugs, where a value is modified
+// while other parts of the code still use it.
+//
+// Borrows are tracked until their guards are released, so guards must be released once done with the value,
+// typically with defer. The borrow tracking is safe for concurrent use.
+type RefCell[T any] struct {
+	mu       sync.Mutex
+	val      T
+	borrows  int
+	mutAt    string
+	mutTaken bool
+}
+
+// Borrow borrows the value of the RefCell. Panics with a *BorrowError if the value is mutably borrowed.
+func (c *RefCell[T]) Borrow() *Ref[T] {
+	ref, err := c.borrow()
+	if err != nil {
+		panic(err)
+	}
+
+	return ref
+}
+
+// BorrowMut mutably borrows the value of the RefCell. Panics with a *BorrowError if the value is borrowed.
+func (c *RefCell[T]) BorrowMut() *RefMut[T] {
+	ref, err := c.borrowMut(1)
+	if err != nil {
+		panic(err)
+	}
+
+	return ref
+}
+
+// TryBorrow borrows the value of the RefCell, returning an Err containing a *BorrowError if the value is mutably
+// borrowed.
+func (c *RefCell[T]) TryBorrow() *Result[*Ref[T]] {
+	return ResultOf(c.borrow())
+}
+
+// TryBorrowMut mutably borrows the value of the RefCell, returning an Err containing a *BorrowError if the value is
+// borrowed.
+func (c *RefCell[T]) TryBorrowMut() *Result[*RefMut[T]] {
+	return ResultOf(c.borrowMut(1))
+}
+
+func (c *RefCell[T]) borrow() (*Ref[T], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mutTaken {
+		return nil, &BorrowError{Mutable: false, Borrows: 0, Location: c.mutAt}
+	}
+
+	c.borrows++
+
+	return &Ref[T]{c: c, released: false}, nil
+}
+
+func (c *RefCell[T]) borrowMut(skip int) (*RefMut[T], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mutTaken || c.borrows > 0 {
+		return nil, &BorrowError{Mutable: true, Borrows: c.borrows, Location: c.mutAt}
+	}
+
+	c.mutTaken = true
+	c.mutAt = callerLocation(skip + 1)
+
+	return &RefMut[T]{c: c, released: false}, nil
+}
+
+// Ref is a shared borrow of the value of a RefCell.
+type Ref[T any] struct {
+	c        *RefCell[T]
+	released bool
+}
+
+// Value returns a pointer to the borrowed value, which must not be modified. Panics if the borrow is released.
+func (r *Ref[T]) Value() *T {
+	if r.released {
+		panic(ErrBorrowReleased)
+	}
+
+	return &r.c.val
+}
+
+// Release ends the borrow. Calling it more than once has no effect.
+func (r *Ref[T]) Release() {
+	if r.released {
+		return
+	}
+
+	r.released = true
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	r.c.borrows--
+}
+
+// RefMut is a mutable borrow of the value of a RefCell.
+type RefMut[T any] struct {
+	c        *RefCell[T]
+	released bool
+}
+
+// Value returns a pointer to the borrowed value. Panics if the borrow is released.
+func (r *RefMut[T]) Value() *T {
+	if r.released {
+		panic(ErrBorrowReleased)
+	}
+
+	return &r.c.val
+}
+
+// Release ends the borrow. Calling it more than once has no effect.
+func (r *RefMut[T]) Release() {
+	if r.released {
+		return
+	}
+
+	r.released = true
+
+	r.c.mu.Lock()
+	defer r.c.mu.Unlock()
+
+	r.c.mutTaken = false
+	r.c.mutAt = ""
+}
+
+func callerLocation(skip int) string {
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "unknown location"
+	}
+
+	return filepath.Base(file) + ":" + strconv.Itoa(line)
+}
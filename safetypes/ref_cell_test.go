@@ -0,0 +1,135 @@
+package st
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+func TestRefCell_Borrow(t *testing.T) {
+	c := NewRefCell(counter{n: 42})
+
+	r1 := c.Borrow()
+	r2 := c.Borrow()
+
+	assert.Equal(t, 42, r1.Value().n)
+	assert.Same(t, r1.Value(), r2.Value())
+
+	r1.Release()
+	r2.Release()
+
+	w := c.BorrowMut()
+	w.Value().n++
+	w.Release()
+
+	r := c.Borrow()
+	defer r.Release()
+
+	assert.Equal(t, 43, r.Value().n)
+}
+
+func TestRefCell_PanicsOnConflictingBorrows(t *testing.T) {
+	t.Run("Borrow while mutably borrowed", func(t *testing.T) {
+		c := NewRefCell(counter{n: 0})
+		w := c.BorrowMut() // Borrowed here
+		line := strconv.Itoa(currentLine() - 1)
+
+		defer func() {
+			r := recover()
+
+			err, ok := r.(*BorrowError)
+			require.True(t, ok)
+			assert.Equal(t, "ref_cell_test.go:"+line, err.Location)
+
+			expected := "cannot borrow RefCell: already mutably borrowed at "
+			assert.EqualError(t, err, expected+"ref_cell_test.go:"+line)
+		}()
+
+		c.Borrow()
+		w.Release()
+	})
+
+	t.Run("BorrowMut while borrowed", func(t *testing.T) {
+		c := NewRefCell(counter{n: 0})
+		c.Borrow()
+		c.Borrow()
+
+		expected := "cannot borrow RefCell as mutable: already borrowed 2 time(s)"
+		assert.PanicsWithError(t, expected, func() { c.BorrowMut() })
+	})
+
+	t.Run("BorrowMut while mutably borrowed", func(t *testing.T) {
+		c := NewRefCell(counter{n: 0})
+		c.BorrowMut()
+
+		assert.Panics(t, func() { c.BorrowMut() })
+	})
+}
+
+func TestRefCell_TryBorrow(t *testing.T) {
+	c := NewRefCell(counter{n: 0})
+
+	r := c.TryBorrow()
+	require.True(t, r.IsOk())
+
+	res := c.TryBorrowMut()
+	require.True(t, res.IsErr())
+
+	var err *BorrowError
+	require.ErrorAs(t, res.UnwrapErr(), &err)
+	assert.Equal(t, &BorrowError{Mutable: true, Borrows: 1, Location: ""}, err)
+
+	r.Unwrap().Release()
+
+	w := c.TryBorrowMut()
+	require.True(t, w.IsOk())
+	assert.True(t, c.TryBorrow().IsErr())
+
+	w.Unwrap().Release()
+	assert.True(t, c.TryBorrow().IsOk())
+}
+
+func TestRefCell_Release(t *testing.T) {
+	c := NewRefCell(counter{n: 0})
+
+	r := c.Borrow()
+	r.Release()
+	r.Release()
+
+	assert.PanicsWithValue(t, ErrBorrowReleased, func() { r.Value() })
+
+	w := c.BorrowMut()
+	w.Release()
+	w.Release()
+
+	assert.PanicsWithValue(t, ErrBorrowReleased, func() { w.Value() })
+	assert.True(t, c.TryBorrowMut().IsOk())
+}
+
+func TestRefCell_CatchesAliasingDuringIteration(t *testing.T) {
+	cells := []*RefCell[counter]{NewRefCell(counter{n: 1}), NewRefCell(counter{n: 2})}
+
+	res := CatchUnwind(func() int {
+		sum := 0
+
+		for c := range it.New(cells).Iter() {
+			r := c.Borrow()
+			sum += r.Value().n
+
+			// Aliased mutation of the element being read
+			cells[0].BorrowMut().Value().n = 0
+
+			r.Release()
+		}
+
+		return sum
+	})
+
+	var err *BorrowError
+	require.ErrorAs(t, res.UnwrapErr(), &err)
+	assert.True(t, err.Mutable)
+}
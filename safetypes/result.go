@@ -1,6 +1,7 @@
 package st
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 )
@@ -77,6 +78,33 @@ func MapResultErr[T any](res *Result[T], f func(error) error) *Result[T] {
 	return Err[T](f(res.UnwrapErr()))
 }
 
+// AndResult returns `other` if the Result is Ok, otherwise returns the Err value of the Result.
+func AndResult[T any, U any](res *Result[T], other *Result[U]) *Result[U] {
+	if res.IsErr() {
+		return Err[U](res.UnwrapErr())
+	}
+
+	return other
+}
+
+// AndThenResult calls `f` if the Result is Ok, otherwise returns the Err value of the Result.
+func AndThenResult[T any, U any](res *Result[T], f func(T) *Result[U]) *Result[U] {
+	if res.IsErr() {
+		return Err[U](res.UnwrapErr())
+	}
+
+	return f(res.Unwrap())
+}
+
+// FlattenResult converts from Result<Result<T>> to Result<T>.
+func FlattenResult[T any](res *Result[*Result[T]]) *Result[T] {
+	if res.IsErr() {
+		return Err[T](res.UnwrapErr())
+	}
+
+	return res.Unwrap()
+}
+
 // Result is a type that represents either success (Ok) or failure (Err).
 type Result[T any] struct {
 	ok  bool
@@ -104,6 +132,11 @@ func (r *Result[T]) IsErrAnd(f func(error) bool) bool {
 	return !r.ok && f(r.err)
 }
 
+// IsErrIs returns `true` if the result is Err and its error matches target, as reported by errors.Is.
+func (r *Result[T]) IsErrIs(target error) bool {
+	return !r.ok && errors.Is(r.err, target)
+}
+
 // Expect returns the contained Ok value, consuming the self value. Panics if the value is an Err, with a panic
 // message including the passed message, and the content of the Err.
 func (r *Result[T]) Expect(msg string) T {
@@ -111,7 +144,7 @@ func (r *Result[T]) Expect(msg string) T {
 		return r.val
 	}
 
-	panic(fmt.Errorf("%s: %w", msg, r.err))
+	panic(newUnwrapPanic(fmt.Errorf("%s: %w", msg, r.err)))
 }
 
 // ExpectErr returns the contained Err value, consuming the self value. Panics if the value is an Ok, with a panic
@@ -121,7 +154,7 @@ func (r *Result[T]) ExpectErr(msg string) error {
 		return r.err
 	}
 
-	panic(fmt.Errorf("%s: %v", msg, r.val))
+	panic(newUnwrapPanic(fmt.Errorf("%s: %v", msg, r.val)))
 }
 
 // Unwrap returns the contained Ok value, consuming the self value. Panics if the value is an Err, with a panic
@@ -131,7 +164,7 @@ func (r *Result[T]) Unwrap() T {
 		return r.val
 	}
 
-	panic(fmt.Errorf("called `Result.Unwrap()` on an `Err` value: %w", r.err))
+	panic(newUnwrapPanic(fmt.Errorf("called `Result.Unwrap()` on an `Err` value: %w", r.err)))
 }
 
 // UnwrapOr returns the contained Ok value or a provided default.
@@ -167,7 +200,7 @@ func (r *Result[T]) UnwrapOrDefault() T {
 // panic message provided by the Ok's value.
 func (r *Result[T]) UnwrapErr() error {
 	if r.ok {
-		panic(fmt.Errorf("called `Result.UnwrapErr()` on an `Ok` value: %v", r.val))
+		panic(newUnwrapPanic(fmt.Errorf("called `Result.UnwrapErr()` on an `Ok` value: %v", r.val)))
 	}
 
 	return r.err
@@ -209,6 +242,24 @@ func (r *Result[T]) AsOptionErr() *Option[error] {
 	return None[error]()
 }
 
+// Or returns the Result if it is Ok, otherwise returns `other`.
+func (r *Result[T]) Or(other *Result[T]) *Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return other
+}
+
+// OrElse returns the Result if it is Ok, otherwise calls `f` with the Err value and returns the result.
+func (r *Result[T]) OrElse(f func(error) *Result[T]) *Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return f(r.err)
+}
+
 // WrapErr wraps the error of an Err, leaving Ok untouched.
 func (r *Result[T]) WrapErr(msg string) *Result[T] {
 	if !r.ok {
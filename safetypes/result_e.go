@@ -0,0 +1,269 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// OkE creates an Ok variant of ResultE from the value.
+func OkE[T any, E error](val T) *ResultE[T, E] {
+	var e E
+
+	return &ResultE[T, E]{
+		ok:  true,
+		val: val,
+		err: e,
+	}
+}
+
+// ErrE creates an Err variant of ResultE from the error.
+func ErrE[T any, E error](err E) *ResultE[T, E] {
+	var v T
+
+	return &ResultE[T, E]{
+		ok:  false,
+		val: v,
+		err: err,
+	}
+}
+
+// ResultEOf creates a ResultE from the given value and error. The error is considered absent if it is nil, e.g. a nil
+// pointer when E is a pointer type.
+//
+// It is only meaningful when E is nillable (a pointer, interface, map, slice, channel or function type): a value of a
+// non-nillable E, such as a struct, is never nil, so ResultEOf always returns an Err for it, even for the zero value.
+// Use OkE or ErrE directly for such error types.
+func ResultEOf[T any, E error](val T, err E) *ResultE[T, E] {
+	if !isNilError(err) {
+		return ErrE[T](err)
+	}
+
+	return OkE[T, E](val)
+}
+
+// ResultEFrom converts a Result to a ResultE. An Err is only converted if its error chain contains an E, in which case
+// that E becomes the error of the ResultE. Returns None if the error can't be converted.
+func ResultEFrom[T any, E error](res *Result[T]) *Option[*ResultE[T, E]] {
+	if res.IsOk() {
+		return Some(OkE[T, E](res.Unwrap()))
+	}
+
+	var target E
+	if !errors.As(res.UnwrapErr(), &target) {
+		return None[*ResultE[T, E]]()
+	}
+
+	return Some(ErrE[T](target))
+}
+
+// MapResultE maps a ResultE<T, E> to ResultE<U, E> by applying a function to a contained Ok value, leaving an Err
+// value untouched.
+func MapResultE[T any, U any, E error](res *ResultE[T, E], f func(T) U) *ResultE[U, E] {
+	if res.IsErr() {
+		return ErrE[U](res.UnwrapErr())
+	}
+
+	return OkE[U, E](f(res.Unwrap()))
+}
+
+// MapResultEOr returns the provided default (if Err), or applies a function to the contained value (if Ok).
+func MapResultEOr[T any, U any, E error](res *ResultE[T, E], def U, f func(T) U) U {
+	if res.IsErr() {
+		return def
+	}
+
+	return f(res.Unwrap())
+}
+
+// MapResultEOrElse maps a ResultE<T, E> to U by applying fallback function default to a contained Err value, or
+// function f to a contained Ok value.
+func MapResultEOrElse[T any, U any, E error](
+	res *ResultE[T, E],
+	factory func() U,
+	mapper func(T) U,
+) U {
+	if res.IsErr() {
+		return factory()
+	}
+
+	return mapper(res.Unwrap())
+}
+
+// MapResultEErr maps a ResultE<T, E> to ResultE<T, F> by applying a function to a contained Err value, leaving an Ok
+// value untouched.
+func MapResultEErr[T any, E error, F error](res *ResultE[T, E], f func(E) F) *ResultE[T, F] {
+	if res.IsOk() {
+		return OkE[T, F](res.Unwrap())
+	}
+
+	return ErrE[T](f(res.UnwrapErr()))
+}
+
+// ResultE is a type that represents either success (Ok) or failure (Err), where the failure has the concrete error
+// type E.
+type ResultE[T any, E error] struct {
+	ok  bool
+	val T
+	err E
+}
+
+// IsOk returns `true` if the result is Ok.
+func (r *ResultE[T, E]) IsOk() bool {
+	return r.ok
+}
+
+// IsOkAnd returns `true` if the result is Ok and the value inside of it matches a predicate.
+func (r *ResultE[T, E]) IsOkAnd(f func(T) bool) bool {
+	return r.ok && f(r.val)
+}
+
+// IsErr returns `true` if the result is Err.
+func (r *ResultE[T, E]) IsErr() bool {
+	return !r.ok
+}
+
+// IsErrAnd returns `true` if the result is Err and the value inside of it matches a predicate.
+func (r *ResultE[T, E]) IsErrAnd(f func(E) bool) bool {
+	return !r.ok && f(r.err)
+}
+
+// Expect returns the contained Ok value, consuming the self value. Panics if the value is an Err, with a panic
+// message including the passed message, and the content of the Err.
+func (r *ResultE[T, E]) Expect(msg string) T {
+	if r.ok {
+		return r.val
+	}
+
+	panic(newUnwrapPanic(fmt.Errorf("%s: %w", msg, r.err)))
+}
+
+// ExpectErr returns the contained Err value, consuming the self value. Panics if the value is an Ok, with a panic
+// message including the passed message, and the content of the Ok.
+func (r *ResultE[T, E]) ExpectErr(msg string) E {
+	if !r.ok {
+		return r.err
+	}
+
+	panic(newUnwrapPanic(fmt.Errorf("%s: %v", msg, r.val)))
+}
+
+// Unwrap returns the contained Ok value, consuming the self value. Panics if the value is an Err, with a panic
+// message provided by the Err's value.
+func (r *ResultE[T, E]) Unwrap() T {
+	if r.ok {
+		return r.val
+	}
+
+	panic(newUnwrapPanic(fmt.Errorf("called `ResultE.Unwrap()` on an `Err` value: %w", r.err)))
+}
+
+// UnwrapOr returns the contained Ok value or a provided default.
+func (r *ResultE[T, E]) UnwrapOr(def T) T {
+	if r.ok {
+		return r.val
+	}
+
+	return def
+}
+
+// UnwrapOrElse returns the contained Ok value or computes it from a closure.
+func (r *ResultE[T, E]) UnwrapOrElse(f func() T) T {
+	if r.ok {
+		return r.val
+	}
+
+	return f()
+}
+
+// UnwrapOrDefault returns the contained Ok value or a default.
+func (r *ResultE[T, E]) UnwrapOrDefault() T {
+	if r.ok {
+		return r.val
+	}
+
+	var def T
+
+	return def
+}
+
+// UnwrapErr returns the contained Err value, consuming the self value. Panics if the value is an Ok, with a custom
+// panic message provided by the Ok's value.
+func (r *ResultE[T, E]) UnwrapErr() E {
+	if r.ok {
+		panic(
+			newUnwrapPanic(fmt.Errorf("called `ResultE.UnwrapErr()` on an `Ok` value: %v", r.val)),
+		)
+	}
+
+	return r.err
+}
+
+// Inspect calls a function with a reference to the contained value if Ok. Returns the original result.
+func (r *ResultE[T, E]) Inspect(f func(*T)) *ResultE[T, E] {
+	if r.ok {
+		f(&r.val)
+	}
+
+	return r
+}
+
+// InspectErr calls a function with the contained error if Err. Returns the original result.
+func (r *ResultE[T, E]) InspectErr(f func(E)) *ResultE[T, E] {
+	if !r.ok {
+		f(r.err)
+	}
+
+	return r
+}
+
+// AsOptionValue converts a ResultE to a Some when res is Ok or None when res is Err.
+func (r *ResultE[T, E]) AsOptionValue() *Option[T] {
+	if r.ok {
+		return Some(r.val)
+	}
+
+	return None[T]()
+}
+
+// AsOptionErr converts a ResultE to a Some when res is Err or None when res is Ok.
+func (r *ResultE[T, E]) AsOptionErr() *Option[E] {
+	if !r.ok {
+		return Some(r.err)
+	}
+
+	return None[E]()
+}
+
+// AsResult converts a ResultE to a Result, erasing the concrete error type.
+func (r *ResultE[T, E]) AsResult() *Result[T] {
+	if r.ok {
+		return Ok(r.val)
+	}
+
+	return Err[T](r.err)
+}
+
+// Expand returns the ResultE as a standard Go (T, E).
+func (r *ResultE[T, E]) Expand() (T, E) {
+	return r.val, r.err
+}
+
+func (r *ResultE[T, E]) String() string {
+	if r.ok {
+		return fmt.Sprintf("Ok(%v)", r.val)
+	}
+
+	return fmt.Sprintf("Err(%v)", r.err)
+}
+
+func isNilError[E error](err E) bool {
+	v := reflect.ValueOf(&err).Elem()
+
+	switch v.Kind() { //nolint:exhaustive // Only nillable kinds can represent a missing error
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,275 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMockError() *MockError {
+	return &MockError{e: fake.RandomStringWithLength(8)}
+}
+
+func TestResultEOf_ReturnsNewResultEFromArgs(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		v := fake.Int()
+
+		res := ResultEOf[int, *MockError](v, nil)
+
+		assert.Equal(t, OkE[int, *MockError](v), res)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := newMockError()
+
+		res := ResultEOf(fake.Int(), err)
+
+		assert.Equal(t, ErrE[int](err), res)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		assert.True(t, ResultEOf[int, error](fake.Int(), nil).IsOk())
+		assert.True(t, ResultEOf[int, error](fake.Int(), errors.New("error")).IsErr())
+	})
+
+	t.Run("value type", func(t *testing.T) {
+		res := ResultEOf(fake.Int(), valueError{})
+
+		assert.Equal(t, ErrE[int](valueError{}), res)
+	})
+}
+
+type valueError struct{}
+
+func (valueError) Error() string {
+	return "value error"
+}
+
+func TestResultEFrom_ConvertsAResult(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		v := fake.Int()
+
+		assert.Equal(t, Some(OkE[int, *MockError](v)), ResultEFrom[int, *MockError](Ok(v)))
+	})
+
+	t.Run("err matching", func(t *testing.T) {
+		err := newMockError()
+		res := Err[int](fmt.Errorf("wrapped: %w", err))
+
+		assert.Equal(t, Some(ErrE[int](err)), ResultEFrom[int, *MockError](res))
+	})
+
+	t.Run("err not matching", func(t *testing.T) {
+		res := Err[int](errors.New(fake.RandomStringWithLength(8)))
+
+		assert.Equal(t, None[*ResultE[int, *MockError]](), ResultEFrom[int, *MockError](res))
+	})
+}
+
+func TestMapResultE_ReturnsANewResultEWithMappedValue(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		v := fake.Int()
+
+		expected := OkE[string, *MockError](strconv.Itoa(v))
+
+		assert.Equal(t, expected, MapResultE(OkE[int, *MockError](v), strconv.Itoa))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := newMockError()
+
+		f := func(int) string {
+			assert.Fail(t, "mapper should not have been called")
+
+			return ""
+		}
+
+		assert.Equal(t, ErrE[string](err), MapResultE(ErrE[int](err), f))
+	})
+}
+
+func TestMapResultEOr_ReturnsTheMappedValueOrDefault(t *testing.T) {
+	v := fake.Int()
+	def := fake.RandomStringWithLength(9)
+
+	assert.Equal(t, strconv.Itoa(v), MapResultEOr(OkE[int, *MockError](v), def, strconv.Itoa))
+	assert.Equal(t, def, MapResultEOr(ErrE[int](newMockError()), def, strconv.Itoa))
+}
+
+func TestMapResultEOrElse_ReturnsTheMappedValueOrCallsDefaultFactory(t *testing.T) {
+	v := fake.Int()
+	def := fake.RandomStringWithLength(9)
+	factory := func() string { return def }
+
+	res := MapResultEOrElse(OkE[int, *MockError](v), factory, strconv.Itoa)
+
+	assert.Equal(t, strconv.Itoa(v), res)
+	assert.Equal(t, def, MapResultEOrElse(ErrE[int](newMockError()), factory, strconv.Itoa))
+}
+
+func TestMapResultEErr_ChangesTheErrorType(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		v := fake.Int()
+
+		f := func(*MockError) *ValidationError {
+			assert.Fail(t, "mapper should not have been called")
+
+			return nil
+		}
+
+		assert.Equal(t, OkE[int, *ValidationError](v), MapResultEErr(OkE[int, *MockError](v), f))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := newMockError()
+		mapped := &ValidationError{Field: "field", Reason: err.Error()}
+
+		f := func(e *MockError) *ValidationError {
+			assert.Same(t, err, e)
+
+			return mapped
+		}
+
+		assert.Equal(t, ErrE[int](mapped), MapResultEErr(ErrE[int](err), f))
+	})
+}
+
+func TestResultE_Predicates(t *testing.T) {
+	ok := OkE[int, *MockError](fake.Int())
+	err := ErrE[int](newMockError())
+
+	assert.True(t, ok.IsOk())
+	assert.False(t, ok.IsErr())
+	assert.True(t, ok.IsOkAnd(func(int) bool { return true }))
+	assert.False(t, ok.IsErrAnd(func(*MockError) bool { return true }))
+
+	assert.False(t, err.IsOk())
+	assert.True(t, err.IsErr())
+	assert.False(t, err.IsOkAnd(func(int) bool { return true }))
+	assert.True(t, err.IsErrAnd(func(*MockError) bool { return true }))
+}
+
+func TestResultE_Unwrap(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		v := fake.Int()
+		r := OkE[int, *MockError](v)
+
+		assert.Equal(t, v, r.Unwrap())
+		assert.Equal(t, v, r.Expect(fake.RandomStringWithLength(8)))
+		assert.Equal(t, v, r.UnwrapOr(fake.Int()))
+		assert.Equal(t, v, r.UnwrapOrElse(fake.Int))
+		assert.Equal(t, v, r.UnwrapOrDefault())
+
+		expected := fmt.Errorf("called `ResultE.UnwrapErr()` on an `Ok` value: %v", v)
+		assert.PanicsWithError(t, expected.Error(), func() {
+			_ = r.UnwrapErr()
+		})
+
+		msg := fake.RandomStringWithLength(8)
+		assert.PanicsWithError(t, fmt.Sprintf("%s: %v", msg, v), func() {
+			_ = r.ExpectErr(msg)
+		})
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := newMockError()
+		r := ErrE[int](err)
+
+		assert.Same(t, err, r.UnwrapErr())
+		assert.Same(t, err, r.ExpectErr(fake.RandomStringWithLength(8)))
+
+		def := fake.Int()
+		assert.Equal(t, def, r.UnwrapOr(def))
+		assert.Equal(t, def, r.UnwrapOrElse(func() int { return def }))
+		assert.Zero(t, r.UnwrapOrDefault())
+
+		expected := fmt.Errorf("called `ResultE.Unwrap()` on an `Err` value: %w", err)
+		assert.PanicsWithError(t, expected.Error(), func() {
+			_ = r.Unwrap()
+		})
+
+		msg := fake.RandomStringWithLength(8)
+		assert.PanicsWithError(t, fmt.Sprintf("%s: %v", msg, err), func() {
+			_ = r.Expect(msg)
+		})
+	})
+}
+
+func TestResultE_Inspect(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		v := fake.Int()
+		r := OkE[int, *MockError](v)
+
+		called := false
+
+		assert.Same(t, r, r.Inspect(func(got *int) {
+			called = true
+
+			assert.Equal(t, v, *got)
+		}))
+		assert.Same(t, r, r.InspectErr(func(*MockError) {
+			assert.Fail(t, "should not have been called")
+		}))
+		assert.True(t, called, "should have been called")
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := newMockError()
+		r := ErrE[int](err)
+
+		called := false
+
+		assert.Same(t, r, r.Inspect(func(*int) {
+			assert.Fail(t, "should not have been called")
+		}))
+		assert.Same(t, r, r.InspectErr(func(got *MockError) {
+			called = true
+
+			assert.Same(t, err, got)
+		}))
+		assert.True(t, called, "should have been called")
+	})
+}
+
+func TestResultE_Conversions(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		v := fake.Int()
+		r := OkE[int, *MockError](v)
+
+		assert.Equal(t, Some(v), r.AsOptionValue())
+		assert.Equal(t, None[*MockError](), r.AsOptionErr())
+		assert.Equal(t, Ok(v), r.AsResult())
+
+		val, err := r.Expand()
+		assert.Equal(t, v, val)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := newMockError()
+		r := ErrE[int](err)
+
+		assert.Equal(t, None[int](), r.AsOptionValue())
+		assert.Equal(t, Some(err), r.AsOptionErr())
+		assert.Equal(t, Err[int](err), r.AsResult())
+
+		var target *MockError
+		require.ErrorAs(t, r.AsResult().UnwrapErr(), &target)
+
+		val, e := r.Expand()
+		assert.Zero(t, val)
+		assert.Same(t, err, e)
+	})
+}
+
+func TestResultE_String(t *testing.T) {
+	v := fake.Int()
+	err := newMockError()
+
+	assert.Equal(t, fmt.Sprintf("Ok(%v)", v), OkE[int, *MockError](v).String())
+	assert.Equal(t, fmt.Sprintf("Err(%v)", err), ErrE[int](err).String())
+}
@@ -0,0 +1,65 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	binaryErr byte = iota
+	binaryOk
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is a byte telling whether the Result is an Ok,
+// followed by either the contained value encoded like in Option.MarshalBinary, or the message of the error.
+//
+// Since only the message is encoded, use MarshalJSON to keep errors matchable with errors.Is and errors.As. Like with
+// MarshalJSON, an Err containing a nil error can't be encoded, and returns an error.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Results are handled as well
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	if !r.ok {
+		if r.err == nil {
+			return nil, errNilErr
+		}
+
+		return append([]byte{binaryErr}, r.err.Error()...), nil
+	}
+
+	data, err := marshalBinary(r.val)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binaryOk}, data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. See MarshalBinary for the expected format. Errors are decoded
+// as plain message errors.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	var v T
+
+	if len(data) == 0 {
+		return errInvalidBinary
+	}
+
+	switch data[0] {
+	case binaryErr:
+		r.ok = false
+		r.err = errors.New(string(data[1:]))
+	case binaryOk:
+		err := unmarshalBinary(data[1:], &v)
+		if err != nil {
+			return err
+		}
+
+		r.ok = true
+		r.err = nil
+	default:
+		return fmt.Errorf("%w: unknown Result tag %d", errInvalidBinary, data[0])
+	}
+
+	r.val = v
+
+	return nil
+}
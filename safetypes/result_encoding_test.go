@@ -0,0 +1,68 @@
+package st
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResult_MarshalBinary(t *testing.T) {
+	roundTrip := func(t *testing.T, res *Result[point]) *Result[point] {
+		t.Helper()
+
+		data, err := res.MarshalBinary()
+		require.NoError(t, err)
+
+		var out Result[point]
+		require.NoError(t, out.UnmarshalBinary(data))
+
+		return &out
+	}
+
+	t.Run("Ok", func(t *testing.T) {
+		res := Ok(point{X: 1, Y: 2})
+
+		assert.Equal(t, res, roundTrip(t, res))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := fmt.Errorf("user 42: %w", errNotFound)
+
+		data, marshalErr := Err[point](err).MarshalBinary()
+		require.NoError(t, marshalErr)
+		assert.Equal(t, append([]byte{0}, "user 42: not found"...), data)
+
+		res := roundTrip(t, Err[point](err))
+		require.True(t, res.IsErr())
+		assert.EqualError(t, res.UnwrapErr(), "user 42: not found")
+	})
+
+	t.Run("Err nil", func(t *testing.T) {
+		_, err := Err[point](nil).MarshalBinary()
+		require.ErrorIs(t, err, errNilErr)
+	})
+
+	t.Run("gob", func(t *testing.T) {
+		results := []*Result[int]{Ok(42), Err[int](errNotFound)}
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(results))
+
+		var out []*Result[int]
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+
+		assert.Equal(t, Ok(42), out[0])
+		assert.EqualError(t, out[1].UnwrapErr(), "not found")
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		var res Result[int]
+
+		require.Error(t, res.UnmarshalBinary(nil))
+		require.Error(t, res.UnmarshalBinary([]byte{2}))
+	})
+}
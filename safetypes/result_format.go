@@ -0,0 +1,82 @@
+package st
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// Format implements fmt.Formatter. A Result is printed as `Ok(...)` or `Err(...)`, with the verb and flags passed
+// through to the contained value or error, e.g. `%+v` or `%q`. `%#v` prints the same as GoString.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Results are handled as well
+func (r Result[T]) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('#'):
+		_, _ = io.WriteString(s, r.GoString())
+	case r.ok:
+		formatVariant(s, verb, "Ok", r.val)
+	default:
+		formatVariant(s, verb, "Err", r.err)
+	}
+}
+
+// GoString implements fmt.GoStringer, printing the Result as the Go expression creating it, e.g. `st.Ok[int](42)`.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Results are handled as well
+func (r Result[T]) GoString() string {
+	if r.ok {
+		return fmt.Sprintf("st.Ok[%s](%#v)", typeName[T](), r.val)
+	}
+
+	return fmt.Sprintf("st.Err[%s](%#v)", typeName[T](), r.err)
+}
+
+// LogValue implements slog.LogValuer. An Ok is logged as a group with its contained value under `ok`, which is itself
+// resolved if it implements slog.LogValuer. An Err is logged as a group with its message under `err`, and the messages
+// of the errors it wraps, if any, under `causes`, or `<nil>` if its error is nil.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Results are handled as well
+func (r Result[T]) LogValue() slog.Value {
+	if r.ok {
+		return slog.GroupValue(slog.Any("ok", r.val))
+	}
+
+	if r.err == nil {
+		return slog.GroupValue(slog.String("err", "<nil>"))
+	}
+
+	attrs := []slog.Attr{slog.String("err", r.err.Error())}
+
+	causes := errorCauses(r.err)
+	if len(causes) > 0 {
+		attrs = append(attrs, slog.Any("causes", causes))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// errorCauses returns the messages of the errors wrapped by err, depth-first.
+func errorCauses(err error) []string {
+	var wrapped []error
+
+	switch e := err.(type) { //nolint:errorlint // Only this link is unwrapped
+	case interface{ Unwrap() error }:
+		wrapped = []error{e.Unwrap()}
+	case interface{ Unwrap() []error }:
+		wrapped = e.Unwrap()
+	}
+
+	var causes []string
+
+	for _, cause := range wrapped {
+		if cause == nil {
+			continue
+		}
+
+		causes = append(causes, cause.Error())
+		causes = append(causes, errorCauses(cause)...)
+	}
+
+	return causes
+}
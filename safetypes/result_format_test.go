@@ -0,0 +1,86 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResult_Format(t *testing.T) {
+	err := errors.New("boom")
+	ctxErr := Err[int](err).Context("loading").UnwrapErr()
+	line := currentLine() - 1
+	file := ctxErr.(*ContextError).File //nolint:forcetypeassert,errorlint // Set by Context
+
+	tests := []struct {
+		format   string
+		res      any
+		expected string
+	}{
+		{"%v", Ok(42), "Ok(42)"},
+		{"%v", *Ok(42), "Ok(42)"},
+		{"%v", Err[int](err), "Err(boom)"},
+		{"%s", Err[int](err), "Err(boom)"},
+		{"%+v", Ok(point{X: 1, Y: 2}), "Ok({X:1 Y:2})"},
+		{"%q", Ok("str"), `Ok("str")`},
+		{"%q", Err[string](err), `Err("boom")`},
+		{"%#v", Ok("str"), `st.Ok[string]("str")`},
+		{"%#v", Err[int](err), `st.Err[int](&errors.errorString{s:"boom"})`},
+		{"%v", Err[int](ctxErr), "Err(loading: boom)"},
+		{
+			"%+v",
+			Err[int](ctxErr),
+			fmt.Sprintf("Err(loading (%s:%d)\n\nCaused by:\n    0: boom)", file, line),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+" "+tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fmt.Sprintf(tt.format, tt.res))
+		})
+	}
+}
+
+func TestResult_GoString(t *testing.T) {
+	assert.Equal(t, "st.Ok[int](42)", Ok(42).GoString())
+
+	expected := `st.Err[int](&errors.errorString{s:"not found"})`
+	assert.Equal(t, expected, Err[int](errNotFound).GoString())
+}
+
+func TestResult_LogValue(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		expected := map[string]any{"ok": map[string]any{"X": 1.0, "Y": 2.0}}
+		assert.Equal(t, expected, logJSON(t, Ok(point{X: 1, Y: 2})))
+	})
+
+	t.Run("Ok with nested Option", func(t *testing.T) {
+		expected := map[string]any{"ok": map[string]any{"some": 1.0}}
+		assert.Equal(t, expected, logJSON(t, Ok(Some(1))))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"err": "not found"}, logJSON(t, Err[int](errNotFound)))
+	})
+
+	t.Run("Err chain", func(t *testing.T) {
+		err := fmt.Errorf("user 42: %w", errors.Join(errNotFound, fmt.Errorf("db: %w", errEmpty)))
+
+		expected := map[string]any{
+			"err": "user 42: not found\ndb: must not be empty",
+			"causes": []any{
+				"not found\ndb: must not be empty",
+				"not found",
+				"db: must not be empty",
+				"must not be empty",
+			},
+		}
+		assert.Equal(t, expected, logJSON(t, Err[int](err)))
+	})
+
+	t.Run("Err nil", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"err": "<nil>"}, logJSON(t, Err[int](nil)))
+	})
+}
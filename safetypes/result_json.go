@@ -0,0 +1,98 @@
+package st
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var errNilErr = errors.New("can't encode an Err containing a nil error")
+
+type resultJSON struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err *errorJSON      `json:"err,omitempty"`
+}
+
+type errorJSON struct {
+	Message string          `json:"message"`
+	Code    string          `json:"code,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. An Ok value is encoded as `{"ok": <value>}`, an Err is encoded as
+// `{"err": {"message": <message>, "code": <code>, "data": <payload>}}`.
+//
+// The code and payload are only present when an ErrorCodec registered with RegisterErrorCodec (or one of its
+// helpers) handles the error. An Err containing a nil error can't be encoded, and returns an error.
+//
+//nolint:recvcheck // Value receiver so that non-addressable Results are handled as well
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.ok {
+		// Marshal through a pointer so nested types are encoded with their own MarshalJSON.
+		val, err := json.Marshal(&r.val)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(resultJSON{Ok: val, Err: nil})
+	}
+
+	if r.err == nil {
+		return nil, errNilErr
+	}
+
+	code, data, err := errorCodecs.encode(r.err)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(resultJSON{
+		Ok: nil,
+		Err: &errorJSON{
+			Message: r.err.Error(),
+			Code:    code,
+			Data:    data,
+		},
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. See MarshalJSON for the expected format.
+//
+// Errors are rebuilt with the ErrorCodec registered under their code, so that errors.Is and errors.As still match
+// them. Errors without a code, or with an unknown one, are decoded as plain message errors.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var raw resultJSON
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case raw.Ok != nil && raw.Err == nil:
+		var v T
+
+		err = json.Unmarshal(raw.Ok, &v)
+		if err != nil {
+			return err
+		}
+
+		r.ok = true
+		r.val = v
+		r.err = nil
+	case raw.Ok == nil && raw.Err != nil:
+		e, err := errorCodecs.decode(raw.Err.Code, raw.Err.Message, raw.Err.Data)
+		if err != nil {
+			return err
+		}
+
+		var v T
+
+		r.ok = false
+		r.val = v
+		r.err = e
+	default:
+		return errors.New(`expected exactly one of "ok" or "err"`)
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package st
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotFound = errors.New("not found")
+
+type ValidationError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+func registerTestErrors(t *testing.T) {
+	t.Helper()
+
+	RegisterSentinelError("not_found", errNotFound)
+	RegisterErrorType[*ValidationError]("validation")
+
+	t.Cleanup(func() {
+		UnregisterError("not_found")
+		UnregisterError("validation")
+	})
+}
+
+func TestResult_MarshalJSON(t *testing.T) {
+	registerTestErrors(t)
+
+	t.Run("Ok", func(t *testing.T) {
+		val := fake.Int()
+
+		data, err := json.Marshal(Ok(val))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, fmt.Sprintf(`{"ok": %d}`, val), string(data))
+	})
+
+	t.Run("Ok with nested option", func(t *testing.T) {
+		data, err := json.Marshal(Ok(*None[int]()))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"ok": null}`, string(data))
+	})
+
+	t.Run("Err unknown", func(t *testing.T) {
+		msg := fake.RandomStringWithLength(8)
+
+		data, err := json.Marshal(Err[int](errors.New(msg)))
+		require.NoError(t, err)
+
+		assert.JSONEq(t, fmt.Sprintf(`{"err": {"message": %q}}`, msg), string(data))
+	})
+
+	t.Run("Err sentinel", func(t *testing.T) {
+		data, err := json.Marshal(Err[int](fmt.Errorf("user 42: %w", errNotFound)))
+		require.NoError(t, err)
+
+		expected := `{"err": {"message": "user 42: not found", "code": "not_found"}}`
+		assert.JSONEq(t, expected, string(data))
+	})
+
+	t.Run("Err typed", func(t *testing.T) {
+		e := &ValidationError{Field: "email", Reason: "missing @"}
+
+		data, err := json.Marshal(Err[int](e))
+		require.NoError(t, err)
+
+		expected := `{"err": {
+			"message": "invalid email: missing @",
+			"code": "validation",
+			"data": {"field": "email", "reason": "missing @"}
+		}}`
+		assert.JSONEq(t, expected, string(data))
+	})
+
+	t.Run("Err nil", func(t *testing.T) {
+		_, err := json.Marshal(Err[int](nil))
+		require.ErrorIs(t, err, errNilErr)
+	})
+}
+
+func TestResult_UnmarshalJSON(t *testing.T) {
+	registerTestErrors(t)
+
+	t.Run("Ok", func(t *testing.T) {
+		var res Result[string]
+		require.NoError(t, json.Unmarshal([]byte(`{"ok": "value"}`), &res))
+
+		assert.Equal(t, Ok("value"), &res)
+	})
+
+	t.Run("Ok null", func(t *testing.T) {
+		var res Result[*int]
+		require.NoError(t, json.Unmarshal([]byte(`{"ok": null}`), &res))
+
+		assert.Equal(t, Ok[*int](nil), &res)
+	})
+
+	t.Run("Err unknown code", func(t *testing.T) {
+		var res Result[string]
+		data := []byte(`{"err": {"message": "boom", "code": "unknown"}}`)
+		require.NoError(t, json.Unmarshal(data, &res))
+
+		assert.True(t, res.IsErr())
+		require.EqualError(t, res.UnwrapErr(), "boom")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := []string{`{}`, `{"ok": 1, "err": {"message": "boom"}}`, `[]`, `{"ok": "str"}`}
+
+		for _, data := range invalid {
+			var res Result[int]
+			assert.Error(t, json.Unmarshal([]byte(data), &res), data)
+		}
+	})
+}
+
+func TestResult_JSONRoundTrip(t *testing.T) {
+	registerTestErrors(t)
+
+	roundTrip := func(t *testing.T, res *Result[int]) *Result[int] {
+		t.Helper()
+
+		data, err := json.Marshal(res)
+		require.NoError(t, err)
+
+		var out Result[int]
+		require.NoError(t, json.Unmarshal(data, &out))
+
+		return &out
+	}
+
+	t.Run("Ok", func(t *testing.T) {
+		res := Ok(fake.Int())
+
+		assert.Equal(t, res, roundTrip(t, res))
+	})
+
+	t.Run("Err sentinel", func(t *testing.T) {
+		res := roundTrip(t, Err[int](errNotFound))
+
+		assert.Same(t, errNotFound, res.UnwrapErr())
+	})
+
+	t.Run("Err wrapped sentinel", func(t *testing.T) {
+		e := fmt.Errorf("user 42: %w", errNotFound)
+		res := roundTrip(t, Err[int](e))
+
+		require.ErrorIs(t, res.UnwrapErr(), errNotFound)
+		assert.EqualError(t, res.UnwrapErr(), e.Error())
+	})
+
+	t.Run("Err typed", func(t *testing.T) {
+		e := &ValidationError{Field: "email", Reason: "missing @"}
+		res := roundTrip(t, Err[int](fmt.Errorf("signup: %w", e)))
+
+		var target *ValidationError
+		require.ErrorAs(t, res.UnwrapErr(), &target)
+		assert.Equal(t, e, target)
+		assert.EqualError(t, res.UnwrapErr(), "signup: invalid email: missing @")
+	})
+
+	t.Run("Err unregistered", func(t *testing.T) {
+		e := &MockError{e: fake.RandomStringWithLength(8)}
+		res := roundTrip(t, Err[int](e))
+
+		var target *MockError
+		require.NotErrorAs(t, res.UnwrapErr(), &target)
+		assert.EqualError(t, res.UnwrapErr(), e.Error())
+	})
+}
+
+func TestRegisterErrorCodec_ReplacesExistingCode(t *testing.T) {
+	registerTestErrors(t)
+
+	other := errors.New("other")
+	RegisterSentinelError("not_found", other)
+
+	data, err := json.Marshal(Err[int](other))
+	require.NoError(t, err)
+
+	var res Result[int]
+	require.NoError(t, json.Unmarshal(data, &res))
+
+	assert.Same(t, other, res.UnwrapErr())
+}
+
+type nilCodec struct{}
+
+func (nilCodec) Encode(err error) (json.RawMessage, bool, error) {
+	if errors.Is(err, errNotFound) {
+		// Registering from a codec must not deadlock.
+		RegisterSentinelError("not_found", errNotFound)
+
+		return json.RawMessage(`{}`), true, nil
+	}
+
+	return nil, false, nil
+}
+
+func (nilCodec) Decode(json.RawMessage) (error, error) {
+	return nil, nil //nolint:nilnil // Misbehaving codec
+}
+
+func TestRegisterErrorCodec_NilDecodedError(t *testing.T) {
+	RegisterErrorCodec("nil", nilCodec{})
+
+	t.Cleanup(func() {
+		UnregisterError("nil")
+		UnregisterError("not_found")
+	})
+
+	data, err := json.Marshal(Err[int](errNotFound))
+	require.NoError(t, err)
+
+	var res Result[int]
+	require.EqualError(
+		t,
+		json.Unmarshal(data, &res),
+		`decoding error with codec "nil": codec decoded a nil error`,
+	)
+}
@@ -165,6 +165,80 @@ func TestMapResultErr_ReturnsANewResultWithMappedResultValue(t *testing.T) {
 	})
 }
 
+func TestAndResult_ReturnsOtherOrErr(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := Ok(fake.Int())
+
+		other := Ok(fake.RandomStringWithLength(8))
+
+		assert.Equal(t, other, AndResult(r, other))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		r := Err[int](err)
+
+		other := Ok(fake.RandomStringWithLength(8))
+
+		assert.Equal(t, Err[string](err), AndResult(r, other))
+	})
+}
+
+func TestAndThenResult_ReturnsMappedResultOrErr(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		for name, expected := range map[string]*Result[string]{
+			"returns Ok":  Ok(fake.RandomStringWithLength(8)),
+			"returns Err": Err[string](errors.New(fake.RandomStringWithLength(8))),
+		} {
+			t.Run(name, func(t *testing.T) {
+				val := fake.Int()
+				r := Ok(val)
+
+				f := func(v int) *Result[string] {
+					assert.Equal(t, val, v)
+
+					return expected
+				}
+
+				assert.Same(t, expected, AndThenResult(r, f))
+			})
+		}
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		r := Err[int](err)
+
+		f := func(int) *Result[string] {
+			assert.Fail(t, "should not have been called")
+
+			return nil
+		}
+
+		assert.Equal(t, Err[string](err), AndThenResult(r, f))
+	})
+}
+
+func TestFlattenResult_RemovesOneLevelOfNesting(t *testing.T) {
+	t.Run("ok ok", func(t *testing.T) {
+		inner := Ok(fake.Int())
+
+		assert.Same(t, inner, FlattenResult(Ok(inner)))
+	})
+
+	t.Run("ok err", func(t *testing.T) {
+		inner := Err[int](errors.New(fake.RandomStringWithLength(8)))
+
+		assert.Same(t, inner, FlattenResult(Ok(inner)))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		assert.Equal(t, Err[int](err), FlattenResult(Err[*Result[int]](err)))
+	})
+}
+
 func TestResult_IsOk(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		val := fake.Int()
@@ -283,6 +357,22 @@ func TestResult_IsErrAnd(t *testing.T) {
 	})
 }
 
+func TestResult_IsErrIs(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(fake.Int())
+
+		assert.False(t, r.IsErrIs(errors.New(fake.RandomStringWithLength(8))))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		r := Err[int](fmt.Errorf("wrapped: %w", err))
+
+		assert.True(t, r.IsErrIs(err))
+		assert.False(t, r.IsErrIs(errors.New(err.Error())))
+	})
+}
+
 func TestResult_Expect(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		val := fake.Int()
@@ -521,6 +611,58 @@ func TestResult_AsOptionErr(t *testing.T) {
 	})
 }
 
+func TestResult_Or(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(fake.Int())
+
+		other := Ok(fake.Int())
+
+		assert.Same(t, r, r.Or(other))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		r := Err[int](errors.New(fake.RandomStringWithLength(8)))
+
+		other := Ok(fake.Int())
+
+		assert.Same(t, other, r.Or(other))
+	})
+}
+
+func TestResult_OrElse(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(fake.Int())
+
+		f := func(error) *Result[int] {
+			assert.Fail(t, "should not have been called")
+
+			return nil
+		}
+
+		assert.Same(t, r, r.OrElse(f))
+	})
+
+	t.Run("Err", func(t *testing.T) {
+		for name, expected := range map[string]*Result[int]{
+			"returns Ok":  Ok(fake.Int()),
+			"returns Err": Err[int](errors.New(fake.RandomStringWithLength(8))),
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := errors.New(fake.RandomStringWithLength(8))
+				r := Err[int](err)
+
+				f := func(e error) *Result[int] {
+					assert.Equal(t, err, e)
+
+					return expected
+				}
+
+				assert.Same(t, expected, r.OrElse(f))
+			})
+		}
+	})
+}
+
 func TestResult_WrapErr(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		val := fake.Int()
@@ -0,0 +1,182 @@
+package st
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// RetryError is the error of the Err returned by Retry when all attempts failed. It matches the errors of all
+// attempts with errors.Is and errors.As.
+type RetryError struct {
+	// Attempts contains the error of each failed attempt, in order.
+	Attempts []error
+	// Err is the error that interrupted the retries before the policy was exhausted, e.g. the context's error, or nil.
+	Err error
+}
+
+func (e *RetryError) Error() string {
+	msgs := make([]string, 0, len(e.Attempts)+1)
+	if e.Err != nil {
+		msgs = append(msgs, e.Err.Error())
+	}
+
+	for i, err := range e.Attempts {
+		msgs = append(msgs, fmt.Sprintf("attempt %d: %v", i+1, err))
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the interrupting error, if any, and the errors of all attempts.
+func (e *RetryError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Attempts)+1)
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+
+	return append(errs, e.Attempts...)
+}
+
+// Backoff computes the delay to wait after the given failed attempt, starting at 1. `prev` is the delay that was
+// waited before that attempt, 0 for the first one.
+type Backoff func(attempt int, prev time.Duration) time.Duration
+
+// ConstantBackoff waits the same delay after each attempt.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int, time.Duration) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff waits `base` after the first attempt, and doubles the delay after each following attempt, up to
+// `maxDelay`.
+func ExponentialBackoff(base time.Duration, maxDelay time.Duration) Backoff {
+	return func(attempt int, _ time.Duration) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < maxDelay; i++ {
+			d *= 2
+		}
+
+		return min(d, maxDelay)
+	}
+}
+
+// DecorrelatedJitterBackoff waits a random delay between `base` and three times the previous delay, up to `maxDelay`.
+// It spreads out the retries of concurrent clients better than ExponentialBackoff.
+func DecorrelatedJitterBackoff(base time.Duration, maxDelay time.Duration) Backoff {
+	return func(_ int, prev time.Duration) time.Duration {
+		upper := max(base, 3*prev)
+		jitter := rand.N(upper - base + 1) //nolint:gosec // Jitter doesn't need a secure source
+
+		return min(base+jitter, maxDelay)
+	}
+}
+
+// RetryOption configures the policy of Retry.
+type RetryOption func(*retryPolicy)
+
+// WithBackoff sets the delay between attempts. Defaults to an ExponentialBackoff starting at 100ms, up to 10s.
+func WithBackoff(b Backoff) RetryOption {
+	return func(p *retryPolicy) {
+		p.backoff = b
+	}
+}
+
+// WithMaxAttempts sets the maximum number of attempts, or no maximum if n <= 0. Defaults to 3.
+func WithMaxAttempts(n int) RetryOption {
+	return func(p *retryPolicy) {
+		p.maxAttempts = n
+	}
+}
+
+// WithMaxElapsed stops retrying when the next attempt would start more than `d` after the first one. Defaults to no
+// maximum.
+func WithMaxElapsed(d time.Duration) RetryOption {
+	return func(p *retryPolicy) {
+		p.maxElapsed = d
+	}
+}
+
+// WithRetryIf only retries the errors for which `f` returns true. Defaults to retrying all errors.
+func WithRetryIf(f func(error) bool) RetryOption {
+	return func(p *retryPolicy) {
+		p.retryIf = f
+	}
+}
+
+// WithRetryClock sets the Clock used to measure elapsed time and wait between attempts. Defaults to SystemClock.
+func WithRetryClock(c Clock) RetryOption {
+	return func(p *retryPolicy) {
+		p.clock = c
+	}
+}
+
+type retryPolicy struct {
+	backoff     Backoff
+	maxAttempts int
+	maxElapsed  time.Duration
+	retryIf     func(error) bool
+	clock       Clock
+}
+
+const (
+	defaultRetryAttempts = 3
+	defaultRetryBase     = 100 * time.Millisecond
+	defaultRetryMaxDelay = 10 * time.Second
+)
+
+// Retry calls `op` until it returns an Ok, the error isn't retryable, or the policy is exhausted, waiting between
+// attempts as set by the Backoff. On failure, it returns an Err containing a *RetryError with the errors of all
+// attempts.
+//
+// Retrying stops early when ctx is done, in which case the context's error is set as RetryError.Err.
+func Retry[T any](
+	ctx context.Context,
+	op func(ctx context.Context) *Result[T],
+	opts ...RetryOption,
+) *Result[T] {
+	p := retryPolicy{
+		backoff:     ExponentialBackoff(defaultRetryBase, defaultRetryMaxDelay),
+		maxAttempts: defaultRetryAttempts,
+		maxElapsed:  0,
+		retryIf:     func(error) bool { return true },
+		clock:       SystemClock,
+	}
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	start := p.clock.Now()
+
+	var (
+		errs  []error
+		delay time.Duration
+	)
+
+	for attempt := 1; ; attempt++ {
+		res := op(ctx)
+		if res.IsOk() {
+			return res
+		}
+
+		errs = append(errs, res.UnwrapErr())
+
+		if !p.retryIf(res.UnwrapErr()) || (p.maxAttempts > 0 && attempt >= p.maxAttempts) {
+			return Err[T](&RetryError{Attempts: errs, Err: nil})
+		}
+
+		delay = p.backoff(attempt, delay)
+		if p.maxElapsed > 0 && p.clock.Now().Add(delay).Sub(start) > p.maxElapsed {
+			return Err[T](&RetryError{Attempts: errs, Err: nil})
+		}
+
+		select {
+		case <-p.clock.After(delay):
+		case <-ctx.Done():
+			return Err[T](&RetryError{Attempts: errs, Err: ctx.Err()})
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package st
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		mu:     sync.Mutex{},
+		now:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		sleeps: nil,
+	}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After advances the clock right away instead of waiting.
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// failTimes returns an operation that fails with the given errors, then succeeds with `val`.
+func failTimes[T any](val T, errs ...error) (func(context.Context) *Result[T], *int) {
+	calls := 0
+
+	return func(context.Context) *Result[T] {
+		calls++
+		if calls <= len(errs) {
+			return Err[T](errs[calls-1])
+		}
+
+		return Ok(val)
+	}, &calls
+}
+
+func TestRetry_ReturnsFirstOk(t *testing.T) {
+	clock := newFakeClock()
+	err := errors.New(fake.RandomStringWithLength(8))
+	op, calls := failTimes(42, err, err)
+
+	res := Retry(t.Context(), op, WithRetryClock(clock), WithBackoff(ConstantBackoff(time.Second)))
+
+	assert.Equal(t, Ok(42), res)
+	assert.Equal(t, 3, *calls)
+	assert.Equal(t, []time.Duration{time.Second, time.Second}, clock.sleeps)
+}
+
+func TestRetry_RecordsAllAttempts(t *testing.T) {
+	clock := newFakeClock()
+	errs := []error{errors.New("first"), errNotFound, errors.New("third")}
+	op, calls := failTimes(0, errs...)
+
+	res := Retry(t.Context(), op, WithRetryClock(clock), WithMaxAttempts(3))
+
+	assert.Equal(t, 3, *calls)
+	require.True(t, res.IsErr())
+	require.ErrorIs(t, res.UnwrapErr(), errNotFound)
+
+	var retryErr *RetryError
+	require.ErrorAs(t, res.UnwrapErr(), &retryErr)
+	assert.Equal(t, errs, retryErr.Attempts)
+	require.NoError(t, retryErr.Err)
+
+	expected := "attempt 1: first\nattempt 2: not found\nattempt 3: third"
+	assert.EqualError(t, res.UnwrapErr(), expected)
+}
+
+func TestRetry_StopsOnNonRetryableError(t *testing.T) {
+	clock := newFakeClock()
+	op, calls := failTimes(0, errors.New("transient"), errNotFound, errors.New("unreachable"))
+
+	res := Retry(t.Context(), op,
+		WithRetryClock(clock),
+		WithMaxAttempts(0),
+		WithRetryIf(func(err error) bool { return !errors.Is(err, errNotFound) }),
+	)
+
+	assert.Equal(t, 2, *calls)
+	assert.EqualError(t, res.UnwrapErr(), "attempt 1: transient\nattempt 2: not found")
+}
+
+func TestRetry_StopsAfterMaxElapsed(t *testing.T) {
+	clock := newFakeClock()
+	err := errors.New(fake.RandomStringWithLength(8))
+	op, calls := failTimes(0, err, err, err, err, err)
+
+	res := Retry(t.Context(), op,
+		WithRetryClock(clock),
+		WithMaxAttempts(0),
+		WithMaxElapsed(5*time.Second),
+		WithBackoff(ConstantBackoff(2*time.Second)),
+	)
+
+	assert.Equal(t, 3, *calls)
+	assert.True(t, res.IsErr())
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, clock.sleeps)
+}
+
+func TestRetry_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	op := func(context.Context) *Result[int] {
+		cancel()
+
+		return Err[int](err)
+	}
+
+	res := Retry(ctx, op, WithBackoff(ConstantBackoff(time.Hour)))
+
+	var retryErr *RetryError
+	require.ErrorAs(t, res.UnwrapErr(), &retryErr)
+	assert.Equal(t, []error{err}, retryErr.Attempts)
+	require.ErrorIs(t, res.UnwrapErr(), context.Canceled)
+	assert.EqualError(t, res.UnwrapErr(), "context canceled\nattempt 1: "+err.Error())
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	delays := make([]time.Duration, 0, 6)
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, b(attempt, 0))
+	}
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	assert.Equal(t, expected, delays)
+	assert.Equal(t, time.Second, b(1000, 0))
+}
+
+func TestDecorrelatedJitterBackoff(t *testing.T) {
+	base := 100 * time.Millisecond
+	maxDelay := 5 * time.Second
+	b := DecorrelatedJitterBackoff(base, maxDelay)
+
+	var prev time.Duration
+
+	for attempt := 1; attempt <= 100; attempt++ {
+		d := b(attempt, prev)
+
+		assert.GreaterOrEqual(t, d, base)
+		assert.LessOrEqual(t, d, min(max(base, 3*prev), maxDelay))
+
+		prev = d
+	}
+}
@@ -0,0 +1,181 @@
+package st
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// NewRwLock creates a RwLock protecting the value.
+func NewRwLock[T any](val T, opts ...LockOption) *RwLock[T] {
+	l := &RwLock[T]{
+		mu:     sync.RWMutex{},
+		val:    val,
+		poison: poison{enabled: false, poisoned: atomic.Bool{}},
+	}
+	l.poison.apply(opts)
+
+	return l
+}
+
+// WithRead calls `f` with the value of the RwLock while holding a read lock, and returns its result as an Ok. It
+// returns an Err containing ErrPoisoned without calling `f` if the RwLock is poisoned. A panic in `f` doesn't poison
+// the RwLock, since readers don't modify the value.
+//
+// `f` must not modify the value, and the pointer passed to it must not be retained after it returns.
+func WithRead[T any, U any](l *RwLock[T], f func(*T) U) *Result[U] {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	err := l.poison.check()
+	if err != nil {
+		return Err[U](err)
+	}
+
+	return Ok(f(&l.val))
+}
+
+// WithWrite calls `f` with the value of the RwLock while holding the write lock, and returns its result as an Ok. It
+// returns an Err containing ErrPoisoned without calling `f` if the RwLock is poisoned.
+//
+// The pointer passed to `f` must not be retained after `f` returns.
+func WithWrite[T any, U any](l *RwLock[T], f func(*T) U) *Result[U] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	err := l.poison.check()
+	if err != nil {
+		return Err[U](err)
+	}
+
+	var res U
+
+	l.poison.run(func() { res = f(&l.val) })
+
+	return Ok(res)
+}
+
+// RwLock is a reader/writer lock that owns the value it protects, like Rust's `RwLock`. The value can only be accessed
+// while holding the lock, either by any number of readers or by a single writer.
+//
+// Like sync.RWMutex, the zero value is an unlocked RwLock holding the zero value of T, without poisoning, and a RwLock
+// must not be copied after first use.
+type RwLock[T any] struct {
+	mu     sync.RWMutex
+	val    T
+	poison poison
+}
+
+// Read calls `f` with the value of the RwLock while holding a read lock, like WithRead for a function without result.
+// It returns an Err containing ErrPoisoned without calling `f` if the RwLock is poisoned.
+//
+// `f` must not modify the value, and the pointer passed to it must not be retained after it returns.
+func (l *RwLock[T]) Read(f func(*T)) *Result[struct{}] {
+	return WithRead(l, func(val *T) struct{} {
+		f(val)
+
+		return struct{}{}
+	})
+}
+
+// Write calls `f` with the value of the RwLock while holding the write lock, like WithWrite for a function without
+// result. It returns an Err containing ErrPoisoned without calling `f` if the RwLock is poisoned.
+//
+// The pointer passed to `f` must not be retained after `f` returns.
+func (l *RwLock[T]) Write(f func(*T)) *Result[struct{}] {
+	return WithWrite(l, func(val *T) struct{} {
+		f(val)
+
+		return struct{}{}
+	})
+}
+
+// TryRead acquires a read lock without blocking. It returns an Ok of a guard giving access to the value, an Ok of None
+// if the write lock is held, or an Err containing ErrPoisoned if the RwLock is poisoned. The guard must be unlocked
+// once done with the value.
+func (l *RwLock[T]) TryRead() *Result[*Option[*RwLockReadGuard[T]]] {
+	if !l.mu.TryRLock() {
+		return Ok(None[*RwLockReadGuard[T]]())
+	}
+
+	err := l.poison.check()
+	if err != nil {
+		l.mu.RUnlock()
+
+		return Err[*Option[*RwLockReadGuard[T]]](err)
+	}
+
+	return Ok(Some(&RwLockReadGuard[T]{l: l, unlocked: false}))
+}
+
+// TryWrite acquires the write lock without blocking. It returns an Ok of a guard giving access to the value, an Ok of
+// None if the lock is held, or an Err containing ErrPoisoned if the RwLock is poisoned. The guard must be unlocked once
+// done with the value.
+//
+// Unlike Write, a panic while holding the guard doesn't poison the RwLock.
+func (l *RwLock[T]) TryWrite() *Result[*Option[*RwLockWriteGuard[T]]] {
+	if !l.mu.TryLock() {
+		return Ok(None[*RwLockWriteGuard[T]]())
+	}
+
+	err := l.poison.check()
+	if err != nil {
+		l.mu.Unlock()
+
+		return Err[*Option[*RwLockWriteGuard[T]]](err)
+	}
+
+	return Ok(Some(&RwLockWriteGuard[T]{l: l, unlocked: false}))
+}
+
+// IsPoisoned returns true if the RwLock is poisoned.
+func (l *RwLock[T]) IsPoisoned() bool {
+	return l.poison.poisoned.Load()
+}
+
+// ClearPoison clears the poisoned state of the RwLock, once the value has been checked or fixed.
+func (l *RwLock[T]) ClearPoison() {
+	l.poison.poisoned.Store(false)
+}
+
+// RwLockReadGuard gives access to the value of a RwLock read-locked with RwLock.TryRead.
+type RwLockReadGuard[T any] struct {
+	l        *RwLock[T]
+	unlocked bool
+}
+
+// Value returns a pointer to the value of the RwLock. The value must not be modified, and the pointer must not be
+// used after the guard is unlocked.
+func (g *RwLockReadGuard[T]) Value() *T {
+	return &g.l.val
+}
+
+// Unlock releases the read lock. Calling it more than once has no effect.
+func (g *RwLockReadGuard[T]) Unlock() {
+	if g.unlocked {
+		return
+	}
+
+	g.unlocked = true
+	g.l.mu.RUnlock()
+}
+
+// RwLockWriteGuard gives access to the value of a RwLock write-locked with RwLock.TryWrite.
+type RwLockWriteGuard[T any] struct {
+	l        *RwLock[T]
+	unlocked bool
+}
+
+// Value returns a pointer to the value of the RwLock. It must not be used after the guard is unlocked.
+func (g *RwLockWriteGuard[T]) Value() *T {
+	return &g.l.val
+}
+
+// Unlock releases the write lock. Calling it more than once has no effect.
+func (g *RwLockWriteGuard[T]) Unlock() {
+	if g.unlocked {
+		return
+	}
+
+	g.unlocked = true
+	g.l.mu.Unlock()
+}
@@ -0,0 +1,104 @@
+package st
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRwLock_ReadWrite(t *testing.T) {
+	l := NewRwLock(counter{n: 0})
+
+	concurrently(100, func(i int) {
+		if i%2 == 0 {
+			assert.True(t, l.Write(func(c *counter) { c.n++ }).IsOk())
+
+			return
+		}
+
+		res := WithRead(l, func(c *counter) int { return c.n })
+		assert.True(t, res.IsOkAnd(func(n int) bool { return n >= 0 && n <= 50 }))
+	})
+
+	assert.Equal(t, Ok(50), WithRead(l, func(c *counter) int { return c.n }))
+	assert.Equal(t, Ok(51), WithWrite(l, func(c *counter) int {
+		c.n++
+
+		return c.n
+	}))
+}
+
+func TestRwLock_ZeroValue(t *testing.T) {
+	var s struct {
+		hits RwLock[counter]
+	}
+
+	require.True(t, s.hits.Write(func(c *counter) { c.n++ }).IsOk())
+	assert.Equal(t, Ok(1), WithRead(&s.hits, func(c *counter) int { return c.n }))
+
+	guard := s.hits.TryRead().Unwrap()
+	require.True(t, guard.IsSome())
+	guard.Unwrap().Unlock()
+
+	assert.False(t, s.hits.IsPoisoned())
+}
+
+func TestRwLock_TryReadTryWrite(t *testing.T) {
+	l := NewRwLock(counter{n: 0})
+
+	r1 := l.TryRead().Unwrap()
+	r2 := l.TryRead().Unwrap()
+	require.True(t, r1.IsSome())
+	require.True(t, r2.IsSome())
+	assert.True(t, l.TryWrite().Unwrap().IsNone())
+
+	r1.Unwrap().Unlock()
+	r1.Unwrap().Unlock()
+	assert.True(t, l.TryWrite().Unwrap().IsNone())
+	r2.Unwrap().Unlock()
+
+	w := l.TryWrite().Unwrap()
+	require.True(t, w.IsSome())
+	assert.True(t, l.TryRead().Unwrap().IsNone())
+	assert.True(t, l.TryWrite().Unwrap().IsNone())
+
+	w.Unwrap().Value().n = 42
+	w.Unwrap().Unlock()
+
+	r := l.TryRead().Unwrap()
+	require.True(t, r.IsSome())
+	assert.Equal(t, 42, r.Unwrap().Value().n)
+	r.Unwrap().Unlock()
+}
+
+func TestRwLock_Poisoning(t *testing.T) {
+	t.Run("read panic", func(t *testing.T) {
+		l := NewRwLock(counter{n: 0}, WithPoisoning())
+
+		assert.Panics(t, func() {
+			_ = l.Read(func(*counter) { panic("boom") })
+		})
+
+		assert.False(t, l.IsPoisoned())
+	})
+
+	t.Run("write panic", func(t *testing.T) {
+		l := NewRwLock(counter{n: 0}, WithPoisoning())
+
+		assert.Panics(t, func() {
+			_ = l.Write(func(*counter) { panic("boom") })
+		})
+
+		assert.True(t, l.IsPoisoned())
+		assert.Equal(t, Err[struct{}](ErrPoisoned), l.Read(func(*counter) {}))
+		assert.Equal(t, Err[struct{}](ErrPoisoned), l.Write(func(*counter) {}))
+		assert.Equal(t, Err[int](ErrPoisoned), WithWrite(l, func(c *counter) int { return c.n }))
+		assert.Equal(t, Err[*Option[*RwLockReadGuard[counter]]](ErrPoisoned), l.TryRead())
+		assert.Equal(t, Err[*Option[*RwLockWriteGuard[counter]]](ErrPoisoned), l.TryWrite())
+
+		l.ClearPoison()
+
+		assert.Equal(t, Ok(struct{}{}), l.Read(func(*counter) {}))
+	})
+}
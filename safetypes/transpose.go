@@ -0,0 +1,97 @@
+package st
+
+import (
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+// TransposeOption transposes an Option of a Result into a Result of an Option.
+//
+// None will be mapped to Ok(None). Some(Ok(v)) and Some(Err(e)) will be mapped to Ok(Some(v)) and Err(e).
+func TransposeOption[T any](opt *Option[*Result[T]]) *Result[*Option[T]] {
+	if opt.IsNone() {
+		return Ok(None[T]())
+	}
+
+	res := opt.Unwrap()
+	if res.IsErr() {
+		return Err[*Option[T]](res.UnwrapErr())
+	}
+
+	return Ok(Some(res.Unwrap()))
+}
+
+// TransposeResult transposes a Result of an Option into an Option of a Result.
+//
+// Ok(None) will be mapped to None. Ok(Some(v)) and Err(e) will be mapped to Some(Ok(v)) and Some(Err(e)).
+func TransposeResult[T any](res *Result[*Option[T]]) *Option[*Result[T]] {
+	if res.IsErr() {
+		return Some(Err[T](res.UnwrapErr()))
+	}
+
+	opt := res.Unwrap()
+	if opt.IsNone() {
+		return None[*Result[T]]()
+	}
+
+	return Some(Ok(opt.Unwrap()))
+}
+
+// FlattenResultsIter converts an iterator of Results into an iterator of values, flattening the Results into the
+// iterator's own error handling. The first Err stops the iteration and is returned as the iterator's error.
+func FlattenResultsIter[T any](iter *it.Iterator[*Result[T]]) *it.Iterator[T] {
+	return it.FromSeq2(func(yield func(T, error) bool) {
+		for res, err := range iter.Iter() {
+			if err == nil && res.IsErr() {
+				err = res.UnwrapErr()
+			}
+
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			if !yield(res.Unwrap(), nil) {
+				return
+			}
+		}
+	})
+}
+
+// TransposeOptionIter converts an iterator of Options of Results into an iterator of Options, as TransposeOption does
+// for a single value. The first Some(Err(e)) stops the iteration and is returned as the iterator's error.
+func TransposeOptionIter[T any](iter *it.Iterator[*Option[*Result[T]]]) *it.Iterator[*Option[T]] {
+	return it.FromSeq2(func(yield func(*Option[T], error) bool) {
+		for opt, err := range iter.Iter() {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			res := TransposeOption(opt)
+			if res.IsErr() {
+				yield(nil, res.UnwrapErr())
+
+				return
+			}
+
+			if !yield(res.Unwrap(), nil) {
+				return
+			}
+		}
+	})
+}
+
+// WrapResultsIter converts an iterator of values into an iterator of Results, the reverse of FlattenResultsIter. The
+// iterator's error is yielded as an Err value instead of failing the iteration.
+func WrapResultsIter[T any](iter *it.Iterator[T]) *it.Iterator[*Result[T]] {
+	return it.FromSeq2(func(yield func(*Result[T], error) bool) {
+		for v, err := range iter.Iter() {
+			if !yield(ResultOf(v, err), nil) {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package st
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+func TestTransposeOption_ReturnsAResultOfAnOption(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		assert.Equal(t, Ok(None[int]()), TransposeOption(None[*Result[int]]()))
+	})
+
+	t.Run("some ok", func(t *testing.T) {
+		val := fake.Int()
+
+		assert.Equal(t, Ok(Some(val)), TransposeOption(Some(Ok(val))))
+	})
+
+	t.Run("some err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		assert.Equal(t, Err[*Option[int]](err), TransposeOption(Some(Err[int](err))))
+	})
+}
+
+func TestTransposeResult_ReturnsAnOptionOfAResult(t *testing.T) {
+	t.Run("ok none", func(t *testing.T) {
+		assert.Equal(t, None[*Result[int]](), TransposeResult(Ok(None[int]())))
+	})
+
+	t.Run("ok some", func(t *testing.T) {
+		val := fake.Int()
+
+		assert.Equal(t, Some(Ok(val)), TransposeResult(Ok(Some(val))))
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		assert.Equal(t, Some(Err[int](err)), TransposeResult(Err[*Option[int]](err)))
+	})
+}
+
+func TestTranspose_RoundTrips(t *testing.T) {
+	for name, opt := range map[string]*Option[*Result[int]]{
+		"none":     None[*Result[int]](),
+		"some ok":  Some(Ok(fake.Int())),
+		"some err": Some(Err[int](errors.New(fake.RandomStringWithLength(8)))),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, opt, TransposeResult(TransposeOption(opt)))
+		})
+	}
+}
+
+func TestTransposeIter_YieldsOkValues(t *testing.T) {
+	output, err := FlattenResultsIter(it.New([]*Result[int]{Ok(1), Ok(2), Ok(3)})).Collect()
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, output)
+}
+
+func TestTransposeIter_StopsAtTheFirstErr(t *testing.T) {
+	err := errors.New(fake.RandomStringWithLength(8))
+	values := []*Result[int]{Ok(1), Err[int](err), Ok(3)}
+
+	var seen []int
+
+	iterErr := FlattenResultsIter(it.New(values)).ForEach(func(v int) {
+		seen = append(seen, v)
+	})
+
+	require.ErrorIs(t, iterErr, err)
+	assert.Equal(t, []int{1}, seen)
+}
+
+func TestTransposeOptionIter_YieldsOptions(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		values := []*Option[*Result[int]]{Some(Ok(1)), None[*Result[int]](), Some(Ok(3))}
+
+		output, err := TransposeOptionIter(it.New(values)).Collect()
+
+		require.NoError(t, err)
+		assert.Equal(t, []*Option[int]{Some(1), None[int](), Some(3)}, output)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+		values := []*Option[*Result[int]]{None[*Result[int]](), Some(Err[int](err)), Some(Ok(3))}
+
+		output, iterErr := TransposeOptionIter(it.New(values)).Collect()
+
+		require.ErrorIs(t, iterErr, err)
+		assert.Nil(t, output)
+	})
+}
+
+func TestIterResults_YieldsErrorsAsValues(t *testing.T) {
+	err := errors.New("slices are not the same length")
+
+	output, iterErr := WrapResultsIter(it.ZipEq([]int{1}, []int{})).Collect()
+
+	require.NoError(t, iterErr)
+	require.Len(t, output, 1)
+	require.EqualError(t, output[0].UnwrapErr(), err.Error())
+
+	ok, iterErr := WrapResultsIter(it.New([]int{1, 2})).Collect()
+
+	require.NoError(t, iterErr)
+	assert.Equal(t, []*Result[int]{Ok(1), Ok(2)}, ok)
+}
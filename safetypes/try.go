@@ -0,0 +1,57 @@
+package st
+
+import (
+	"errors"
+)
+
+// UnwrapPanic is the value of the panics raised by the Unwrap and Expect methods of Option, Result, ResultE and Either.
+// It wraps the error describing the failure, which itself wraps the contained error when there is one.
+type UnwrapPanic struct {
+	err error
+}
+
+func newUnwrapPanic(err error) *UnwrapPanic {
+	return &UnwrapPanic{
+		err: err,
+	}
+}
+
+func (p *UnwrapPanic) Error() string {
+	return p.err.Error()
+}
+
+// Unwrap returns the error describing the failure.
+func (p *UnwrapPanic) Unwrap() error {
+	return p.err
+}
+
+// Try calls `f` and returns its result as an Ok. If `f` panics because of a failed Unwrap or Expect, the panic is
+// recovered and returned as an Err, with the error chain of the unwrapped value intact. Any other panic is propagated.
+//
+// This allows writing straight-line code with Unwrap, similarly to Rust's `?` operator:
+//
+//	res := st.Try(func() int {
+//		a := parse(x).Unwrap()
+//		b := parse(y).Unwrap()
+//
+//		return a + b
+//	})
+func Try[T any](f func() T) (res *Result[T]) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		var p *UnwrapPanic
+
+		err, ok := r.(error)
+		if !ok || !errors.As(err, &p) {
+			panic(r)
+		}
+
+		res = Err[T](p.err)
+	}()
+
+	return Ok(f())
+}
@@ -0,0 +1,117 @@
+package st
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnwrap_PanicsWithUnwrapPanic(t *testing.T) {
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	for name, f := range map[string]func(){
+		"Option.Unwrap":    func() { None[int]().Unwrap() },
+		"Option.Expect":    func() { None[int]().Expect("msg") },
+		"Result.Unwrap":    func() { Err[int](err).Unwrap() },
+		"Result.Expect":    func() { Err[int](err).Expect("msg") },
+		"Result.UnwrapErr": func() { _ = Ok(1).UnwrapErr() },
+		"Result.ExpectErr": func() { _ = Ok(1).ExpectErr("msg") },
+		"ResultE.Unwrap":   func() { ErrE[int](err).Unwrap() },
+		"ResultE.Expect":   func() { ErrE[int](err).Expect("msg") },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+
+				assert.IsType(t, &UnwrapPanic{}, r) //nolint:exhaustruct // Only the type matters
+			}()
+
+			f()
+		})
+	}
+}
+
+func TestTry_ReturnsOkIfNoPanic(t *testing.T) {
+	val := fake.Int()
+
+	res := Try(func() int {
+		return Ok(val).Unwrap() + Some(1).Unwrap()
+	})
+
+	assert.Equal(t, Ok(val+1), res)
+}
+
+func TestTry_ReturnsErrOnUnwrapPanic(t *testing.T) {
+	t.Run("Result.Unwrap", func(t *testing.T) {
+		err := &ValidationError{Field: "email", Reason: "missing @"}
+
+		res := Try(func() int {
+			a := Ok(1).Unwrap()
+			b := Err[int](err).Unwrap()
+
+			assert.Fail(t, "should not have been reached")
+
+			return a + b
+		})
+
+		require.True(t, res.IsErr())
+		require.ErrorIs(t, res.UnwrapErr(), err)
+
+		expected := "called `Result.Unwrap()` on an `Err` value: " + err.Error()
+		assert.EqualError(t, res.UnwrapErr(), expected)
+
+		var target *ValidationError
+		require.ErrorAs(t, res.UnwrapErr(), &target)
+		assert.Same(t, err, target)
+	})
+
+	t.Run("Result.Expect", func(t *testing.T) {
+		res := Try(func() int {
+			return Err[int](errNotFound).Expect("loading user")
+		})
+
+		require.ErrorIs(t, res.UnwrapErr(), errNotFound)
+		assert.EqualError(t, res.UnwrapErr(), "loading user: not found")
+	})
+
+	t.Run("Option.Unwrap", func(t *testing.T) {
+		res := Try(func() int {
+			return None[int]().Unwrap()
+		})
+
+		assert.EqualError(t, res.UnwrapErr(), "called `Option.Unwrap()` on a `None` value")
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		res := Try(func() int {
+			inner := Try(func() int {
+				return Err[int](errNotFound).Unwrap()
+			})
+
+			return inner.Expect("outer")
+		})
+
+		require.ErrorIs(t, res.UnwrapErr(), errNotFound)
+
+		expected := "outer: called `Result.Unwrap()` on an `Err` value: not found"
+		assert.EqualError(t, res.UnwrapErr(), expected)
+	})
+}
+
+func TestTry_PropagatesOtherPanics(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		assert.PanicsWithValue(t, err, func() {
+			Try(func() int { panic(err) })
+		})
+	})
+
+	t.Run("non error", func(t *testing.T) {
+		assert.PanicsWithValue(t, "boom", func() {
+			Try(func() int { panic("boom") })
+		})
+	})
+}
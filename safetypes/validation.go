@@ -0,0 +1,306 @@
+package st
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+// FieldError is an error attached to the path of the field that failed validation, e.g. `user.emails[1]`.
+type FieldError struct {
+	// Path is the path of the invalid field. It is empty if the error isn't attached to a field.
+	Path string
+	// Err is the validation error.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the validation error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is the error of an invalid Validation converted to a Result. Like the errors returned by
+// errors.Join, it matches all of its errors with errors.Is and errors.As.
+type ValidationErrors struct {
+	// Errors contains a *FieldError for each validation failure.
+	Errors []*FieldError
+}
+
+func (e *ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the validation failures.
+func (e *ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// Valid creates a valid Validation from the value.
+func Valid[T any](val T) *Validation[T] {
+	return &Validation[T]{
+		val:  val,
+		errs: nil,
+	}
+}
+
+// Invalid creates an invalid Validation from the errors, which are not attached to any field. Nil errors are dropped.
+// Panics if no non-nil error is given, since the Validation would be valid.
+func Invalid[T any](errs ...error) *Validation[T] {
+	var v T
+
+	fieldErrs := make([]*FieldError, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			fieldErrs = append(fieldErrs, &FieldError{Path: "", Err: err})
+		}
+	}
+
+	if len(fieldErrs) == 0 {
+		panic(errors.New("called `Invalid()` without a non-nil error"))
+	}
+
+	return &Validation[T]{
+		val:  v,
+		errs: fieldErrs,
+	}
+}
+
+// Validate runs all checks against the value of the field at the given path. Unlike a Result, checks keep running
+// after a failure, and the Validation is invalid if any check returns an error.
+func Validate[T any](path string, val T, checks ...func(T) error) *Validation[T] {
+	res := Valid(val)
+
+	for _, check := range checks {
+		err := check(val)
+		if err != nil {
+			res.errs = append(res.errs, &FieldError{Path: path, Err: err})
+		}
+	}
+
+	return res
+}
+
+// ValidationOf creates a Validation of the field at the given path from a Result.
+func ValidationOf[T any](path string, res *Result[T]) *Validation[T] {
+	if res.IsErr() {
+		return Invalid[T](res.UnwrapErr()).At(path)
+	}
+
+	return Valid(res.Unwrap())
+}
+
+// MapValidation maps a Validation<T> to Validation<U> by applying a function to a valid value, leaving the errors of
+// an invalid Validation untouched.
+func MapValidation[T any, U any](v *Validation[T], f func(T) U) *Validation[U] {
+	if v.IsInvalid() {
+		var u U
+
+		return &Validation[U]{val: u, errs: v.errs}
+	}
+
+	return Valid(f(v.val))
+}
+
+// ValidateAll combines Validations of the same type. The result is valid if all Validations are valid, otherwise it
+// contains the errors of all of them.
+func ValidateAll[T any](vs ...*Validation[T]) *Validation[[]T] {
+	vals := make([]T, 0, len(vs))
+
+	var errs []*FieldError
+
+	for _, v := range vs {
+		vals = append(vals, v.val)
+		errs = append(errs, v.errs...)
+	}
+
+	return combined(vals, errs)
+}
+
+// Combine2 combines two Validations with `f`. The result is valid if both Validations are valid, otherwise it
+// contains the errors of both.
+func Combine2[A any, B any, R any](
+	a *Validation[A],
+	b *Validation[B],
+	f func(A, B) R,
+) *Validation[R] {
+	errs := concatErrs(a.errs, b.errs)
+	if len(errs) > 0 {
+		var r R
+
+		return &Validation[R]{val: r, errs: errs}
+	}
+
+	return Valid(f(a.val, b.val))
+}
+
+// Combine3 combines three Validations with `f`. The result is valid if all Validations are valid, otherwise it
+// contains the errors of all of them.
+func Combine3[A any, B any, C any, R any](
+	a *Validation[A],
+	b *Validation[B],
+	c *Validation[C],
+	f func(A, B, C) R,
+) *Validation[R] {
+	errs := concatErrs(a.errs, b.errs, c.errs)
+	if len(errs) > 0 {
+		var r R
+
+		return &Validation[R]{val: r, errs: errs}
+	}
+
+	return Valid(f(a.val, b.val, c.val))
+}
+
+// Combine4 combines four Validations with `f`. The result is valid if all Validations are valid, otherwise it
+// contains the errors of all of them.
+func Combine4[A any, B any, C any, D any, R any](
+	a *Validation[A],
+	b *Validation[B],
+	c *Validation[C],
+	d *Validation[D],
+	f func(A, B, C, D) R,
+) *Validation[R] {
+	errs := concatErrs(a.errs, b.errs, c.errs, d.errs)
+	if len(errs) > 0 {
+		var r R
+
+		return &Validation[R]{val: r, errs: errs}
+	}
+
+	return Valid(f(a.val, b.val, c.val, d.val))
+}
+
+// ValidateIter validates each element of the iterator with `f`, and keeps going past failures. The errors of each
+// element are attached to its index, e.g. `[2].email`. An iterator error stops the validation and is added to the
+// errors.
+func ValidateIter[T any, U any](iter *it.Iterator[T], f func(T) *Validation[U]) *Validation[[]U] {
+	vals := make([]U, 0)
+
+	var errs []*FieldError
+
+	idx := 0
+
+	for v, err := range iter.Iter() {
+		if err != nil {
+			errs = append(errs, &FieldError{Path: "", Err: err})
+
+			break
+		}
+
+		res := f(v).At("[" + strconv.Itoa(idx) + "]")
+		vals = append(vals, res.val)
+		errs = append(errs, res.errs...)
+
+		idx++
+	}
+
+	return combined(vals, errs)
+}
+
+// Validation is a type that represents either a valid value, or all the errors found while validating it.
+//
+// Unlike Result, which stops at the first Err, Validations are meant to be combined so that every error is reported.
+type Validation[T any] struct {
+	val  T
+	errs []*FieldError
+}
+
+// IsValid returns true if the Validation has no errors.
+func (v *Validation[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// IsInvalid returns true if the Validation has errors.
+func (v *Validation[T]) IsInvalid() bool {
+	return len(v.errs) > 0
+}
+
+// Errors returns the errors of the Validation, each attached to the path of its field.
+func (v *Validation[T]) Errors() []*FieldError {
+	return v.errs
+}
+
+// At nests the Validation under the given path: the paths of all errors are prefixed with it.
+func (v *Validation[T]) At(path string) *Validation[T] {
+	if len(v.errs) == 0 || path == "" {
+		return v
+	}
+
+	errs := make([]*FieldError, 0, len(v.errs))
+	for _, err := range v.errs {
+		errs = append(errs, &FieldError{Path: joinPath(path, err.Path), Err: err.Err})
+	}
+
+	return &Validation[T]{
+		val:  v.val,
+		errs: errs,
+	}
+}
+
+// AsResult converts a Validation to an Ok when it is valid, or an Err containing a *ValidationErrors when it is
+// invalid.
+func (v *Validation[T]) AsResult() *Result[T] {
+	if len(v.errs) == 0 {
+		return Ok(v.val)
+	}
+
+	return Err[T](&ValidationErrors{Errors: v.errs})
+}
+
+func (v *Validation[T]) String() string {
+	if len(v.errs) == 0 {
+		return fmt.Sprintf("Valid(%v)", v.val)
+	}
+
+	return fmt.Sprintf("Invalid(%v)", (&ValidationErrors{Errors: v.errs}).Error())
+}
+
+func combined[T any](vals []T, errs []*FieldError) *Validation[[]T] {
+	if len(errs) > 0 {
+		return &Validation[[]T]{val: nil, errs: errs}
+	}
+
+	return Valid(vals)
+}
+
+func concatErrs(errs ...[]*FieldError) []*FieldError {
+	var res []*FieldError
+
+	for _, e := range errs {
+		res = append(res, e...)
+	}
+
+	return res
+}
+
+func joinPath(prefix string, path string) string {
+	switch {
+	case path == "":
+		return prefix
+	case strings.HasPrefix(path, "["):
+		return prefix + path
+	default:
+		return prefix + "." + path
+	}
+}
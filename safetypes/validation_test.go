@@ -0,0 +1,263 @@
+package st
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/RogueConsultingDev/grust/it"
+)
+
+var (
+	errEmpty    = errors.New("must not be empty")
+	errTooShort = errors.New("too short")
+	errNoAt     = errors.New("missing @")
+)
+
+func notEmpty(s string) error {
+	if s == "" {
+		return errEmpty
+	}
+
+	return nil
+}
+
+func minLen(n int) func(string) error {
+	return func(s string) error {
+		if len(s) < n {
+			return errTooShort
+		}
+
+		return nil
+	}
+}
+
+func hasAt(s string) error {
+	if !strings.Contains(s, "@") {
+		return errNoAt
+	}
+
+	return nil
+}
+
+type signup struct {
+	Name  string
+	Email string
+}
+
+func validateSignup(name string, email string) *Validation[signup] {
+	return Combine2(
+		Validate("name", name, notEmpty, minLen(3)),
+		Validate("email", email, notEmpty, hasAt),
+		func(n string, e string) signup { return signup{Name: n, Email: e} },
+	)
+}
+
+func TestValidate_RunsAllChecks(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v := Validate("name", "John", notEmpty, minLen(3))
+
+		assert.True(t, v.IsValid())
+		assert.False(t, v.IsInvalid())
+		assert.Empty(t, v.Errors())
+		assert.Equal(t, Ok("John"), v.AsResult())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v := Validate("name", "", notEmpty, minLen(3))
+
+		assert.False(t, v.IsValid())
+		assert.True(t, v.IsInvalid())
+
+		expected := []*FieldError{
+			{Path: "name", Err: errEmpty},
+			{Path: "name", Err: errTooShort},
+		}
+		assert.Equal(t, expected, v.Errors())
+	})
+}
+
+func TestValid_Invalid(t *testing.T) {
+	val := fake.Int()
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	assert.Equal(t, Ok(val), Valid(val).AsResult())
+
+	res := Invalid[int](err).AsResult()
+	require.ErrorIs(t, res.UnwrapErr(), err)
+	assert.EqualError(t, res.UnwrapErr(), err.Error())
+
+	nilDropped := Invalid[int](nil, err, nil)
+	assert.True(t, nilDropped.IsInvalid())
+	assert.EqualError(t, nilDropped.AsResult().UnwrapErr(), err.Error())
+
+	assert.Panics(t, func() { Invalid[int]() })
+	assert.Panics(t, func() { Invalid[int](nil) })
+}
+
+func TestValidationOf_ConvertsAResult(t *testing.T) {
+	val := fake.Int()
+	err := errors.New(fake.RandomStringWithLength(8))
+
+	assert.Equal(t, Valid(val), ValidationOf("age", Ok(val)))
+
+	expected := []*FieldError{{Path: "age", Err: err}}
+	assert.Equal(t, expected, ValidationOf("age", Err[int](err)).Errors())
+}
+
+func TestMapValidation_MapsValidValues(t *testing.T) {
+	assert.Equal(t, Valid("42"), MapValidation(Valid(42), strconv.Itoa))
+
+	v := Validate("name", "", notEmpty)
+	f := func(string) int {
+		assert.Fail(t, "mapper should not have been called")
+
+		return 0
+	}
+
+	assert.Equal(t, v.Errors(), MapValidation(v, f).Errors())
+}
+
+func TestCombine_AccumulatesAllErrors(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v := validateSignup("John", "john@example.com")
+
+		assert.Equal(t, Ok(signup{Name: "John", Email: "john@example.com"}), v.AsResult())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		res := validateSignup("Jo", "").AsResult()
+
+		require.True(t, res.IsErr())
+
+		err := res.UnwrapErr()
+		require.ErrorIs(t, err, errTooShort)
+		require.ErrorIs(t, err, errEmpty)
+		require.ErrorIs(t, err, errNoAt)
+
+		var multi *ValidationErrors
+		require.ErrorAs(t, err, &multi)
+
+		expected := []*FieldError{
+			{Path: "name", Err: errTooShort},
+			{Path: "email", Err: errEmpty},
+			{Path: "email", Err: errNoAt},
+		}
+		assert.Equal(t, expected, multi.Errors)
+		assert.EqualError(t, err, "name: too short\nemail: must not be empty\nemail: missing @")
+	})
+
+	t.Run("Combine3", func(t *testing.T) {
+		v := Combine3(
+			Valid(1),
+			Validate("b", "", notEmpty),
+			Validate("c", "x", minLen(2)),
+			func(int, string, string) int { return 0 },
+		)
+
+		expected := []*FieldError{{Path: "b", Err: errEmpty}, {Path: "c", Err: errTooShort}}
+		assert.Equal(t, expected, v.Errors())
+
+		ok := Combine3(Valid(1), Valid(2), Valid(3), func(a, b, c int) int { return a + b + c })
+		assert.Equal(t, Valid(6), ok)
+	})
+
+	t.Run("Combine4", func(t *testing.T) {
+		v := Combine4(
+			Validate("a", "", notEmpty),
+			Valid(2),
+			Valid(3),
+			Validate("d", "x", hasAt),
+			func(string, int, int, string) int { return 0 },
+		)
+
+		expected := []*FieldError{{Path: "a", Err: errEmpty}, {Path: "d", Err: errNoAt}}
+		assert.Equal(t, expected, v.Errors())
+
+		sum := func(a, b, c, d int) int { return a + b + c + d }
+
+		ok := Combine4(Valid(1), Valid(2), Valid(3), Valid(4), sum)
+		assert.Equal(t, Valid(10), ok)
+	})
+}
+
+func TestValidateAll_AccumulatesAllErrors(t *testing.T) {
+	assert.Equal(t, Valid([]string{"a", "b"}), ValidateAll(Valid("a"), Valid("b")))
+
+	v := ValidateAll(Validate("a", "", notEmpty), Valid("b"), Validate("c", "", notEmpty))
+
+	expected := []*FieldError{{Path: "a", Err: errEmpty}, {Path: "c", Err: errEmpty}}
+	assert.Equal(t, expected, v.Errors())
+}
+
+func TestValidation_At(t *testing.T) {
+	v := validateSignup("", "john").At("user").At("payload")
+
+	expected := []*FieldError{
+		{Path: "payload.user.name", Err: errEmpty},
+		{Path: "payload.user.name", Err: errTooShort},
+		{Path: "payload.user.email", Err: errNoAt},
+	}
+	assert.Equal(t, expected, v.Errors())
+
+	valid := Valid(1)
+	assert.Same(t, valid, valid.At("field"))
+
+	invalid := Invalid[int](errEmpty).At("field")
+	assert.Equal(t, []*FieldError{{Path: "field", Err: errEmpty}}, invalid.Errors())
+}
+
+func TestValidateIter_KeepsGoingPastFailures(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		users := it.New([]signup{{Name: "John", Email: "john@example.com"}})
+
+		v := ValidateIter(users, func(u signup) *Validation[signup] {
+			return validateSignup(u.Name, u.Email)
+		})
+
+		assert.Equal(t, Valid([]signup{{Name: "John", Email: "john@example.com"}}), v)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		users := it.New([]signup{
+			{Name: "", Email: "john@example.com"},
+			{Name: "Jane", Email: "jane@example.com"},
+			{Name: "Bob", Email: "bob"},
+		})
+
+		seen := 0
+		v := ValidateIter(users, func(u signup) *Validation[signup] {
+			seen++
+
+			return validateSignup(u.Name, u.Email)
+		})
+
+		expected := []*FieldError{
+			{Path: "[0].name", Err: errEmpty},
+			{Path: "[0].name", Err: errTooShort},
+			{Path: "[2].email", Err: errNoAt},
+		}
+		assert.Equal(t, expected, v.Errors())
+		assert.Equal(t, 3, seen)
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		err := errors.New(fake.RandomStringWithLength(8))
+
+		v := ValidateIter(failingIter([]string{""}, err), func(s string) *Validation[string] {
+			return Validate("", s, notEmpty)
+		})
+
+		expected := []*FieldError{{Path: "[0]", Err: errEmpty}, {Path: "", Err: err}}
+		assert.Equal(t, expected, v.Errors())
+	})
+}
+
+func TestValidation_String(t *testing.T) {
+	assert.Equal(t, "Valid(42)", Valid(42).String())
+	assert.Equal(t, "Invalid(name: must not be empty)", Validate("name", "", notEmpty).String())
+}